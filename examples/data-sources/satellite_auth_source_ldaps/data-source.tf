@@ -0,0 +1 @@
+data "satellite_auth_source_ldaps" "all" {}
@@ -0,0 +1,18 @@
+data "satellite_content_view" "rhel9" {
+  organization_id = 2
+  name            = "RHEL9"
+}
+
+data "satellite_lifecycle_environment" "production" {
+  organization_id = 2
+  search          = "name=Production"
+}
+
+data "satellite_content_view_version" "rhel9_production" {
+  content_view_id = data.satellite_content_view.rhel9.id
+  environment_id  = data.satellite_lifecycle_environment.production.id
+}
+
+output "rhel9_production_version" {
+  value = data.satellite_content_view_version.rhel9_production.version
+}
@@ -0,0 +1,4 @@
+data "satellite_content_views" "composites" {
+  organization_id = 2
+  composite       = true
+}
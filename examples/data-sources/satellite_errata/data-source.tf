@@ -0,0 +1,14 @@
+data "satellite_errata" "critical_security" {
+  organization_id = 2
+  type            = "security"
+  severity        = "Critical"
+  issued_after    = "2024-01-01"
+}
+
+output "critical_security_errata_ids" {
+  value = [for e in data.satellite_errata.critical_security.errata : e.errata_id]
+}
+
+output "critical_security_applicable_hosts" {
+  value = { for e in data.satellite_errata.critical_security.errata : e.errata_id => e.hosts_applicable_count }
+}
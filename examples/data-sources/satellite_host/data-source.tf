@@ -0,0 +1,3 @@
+data "satellite_host" "web01" {
+  search = "name=web01.example.com"
+}
@@ -0,0 +1,3 @@
+data "satellite_hostgroup" "web" {
+  search = "title=Base/RHEL9/Web"
+}
@@ -0,0 +1,7 @@
+data "satellite_hosts" "web" {
+  search = "hostgroup_title=Base/RHEL9/Web"
+}
+
+output "web_hosts_needing_security_errata" {
+  value = [for h in data.satellite_hosts.web.hosts : h.name if h.errata_security_count > 0]
+}
@@ -0,0 +1,3 @@
+data "satellite_lifecycle_environments" "org" {
+  organization_id = 2
+}
@@ -0,0 +1,6 @@
+data "satellite_module_streams" "postgresql" {
+  organization_id         = 2
+  content_view_version_id = 15
+  name                    = "postgresql"
+  stream                  = "15"
+}
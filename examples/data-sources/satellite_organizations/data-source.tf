@@ -0,0 +1 @@
+data "satellite_organizations" "all" {}
@@ -0,0 +1,17 @@
+data "satellite_packages" "openssl_production" {
+  organization_id = 2
+  environment_id  = 4
+  name            = "openssl"
+  arch            = "x86_64"
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.packages) > 0
+      error_message = "openssl is not available in the Production environment."
+    }
+  }
+}
+
+output "openssl_nvras" {
+  value = [for p in data.satellite_packages.openssl_production.packages : p.nvra]
+}
@@ -0,0 +1,3 @@
+data "satellite_roles" "custom" {
+  search = "builtin=false"
+}
@@ -0,0 +1,20 @@
+data "satellite_subscriptions" "rhel" {
+  organization_id = 2
+  search          = "name ~ \"Red Hat Enterprise Linux\""
+}
+
+resource "satellite_activation_key" "rhel" {
+  name            = "RHEL"
+  organization_id = 2
+
+  dynamic "subscription" {
+    for_each = data.satellite_subscriptions.rhel.subscriptions
+    content {
+      id = subscription.value.id
+    }
+  }
+}
+
+output "expiring_pools" {
+  value = [for s in data.satellite_subscriptions.rhel.subscriptions : s.pool_id if timecmp(s.end_date, timeadd(plantimestamp(), "720h")) < 0]
+}
@@ -0,0 +1,3 @@
+data "satellite_users" "ldap" {
+  search = "auth_source_type=AuthSourceLdap"
+}
@@ -1,3 +1,30 @@
 resource "satellite_activation_key" "key" {
-  name = "foo"
+  name            = "foo"
+  organization_id = 10
+  auto_attach     = false
+  release_version = "8.6"
+  service_level   = "Premium"
+  purpose_role    = "Red Hat Enterprise Linux Server"
+  purpose_usage   = "Production"
+
+  subscription {
+    id       = 42
+    quantity = 1
+  }
+
+  content_override {
+    content_label = "epel-8"
+    value         = "enabled"
+  }
+}
+
+resource "satellite_activation_key" "multi_cv" {
+  name            = "rhel9-app"
+  organization_id = 10
+  copy_from_id    = satellite_activation_key.key.id
+
+  content_view_environments = [
+    "Production/RHEL9",
+    "Production/App",
+  ]
 }
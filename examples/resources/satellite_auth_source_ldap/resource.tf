@@ -0,0 +1,19 @@
+resource "satellite_auth_source_ldap" "ad" {
+  name                     = "Example AD"
+  host                     = "ad.example.com"
+  port                     = 636
+  tls                      = true
+  server_type              = "active_directory"
+  account                  = "CN=svc-satellite,OU=Service Accounts,DC=example,DC=com"
+  account_password         = var.ldap_bind_password
+  account_password_version = 1
+  base_dn                  = "DC=example,DC=com"
+  groups_base              = "OU=Groups,DC=example,DC=com"
+  attr_login               = "sAMAccountName"
+  attr_firstname           = "givenName"
+  attr_lastname            = "sn"
+  attr_mail                = "mail"
+  onthefly_register        = true
+  usergroup_sync           = true
+  organization_ids         = [10]
+}
@@ -0,0 +1,33 @@
+resource "satellite_host" "web01" {
+  name                     = "web01"
+  organization_id          = 10
+  location_id              = 2
+  hostgroup_id             = 5
+  content_view_id          = 12
+  lifecycle_environment_id = 3
+  compute_resource_id      = 1
+  compute_attributes = jsonencode({
+    cpus      = 2
+    memory_mb = 4096
+  })
+
+  interface {
+    identifier = "eth0"
+    subnet_id  = 4
+    primary    = true
+    provision  = true
+  }
+
+  parameter {
+    name  = "patch_group"
+    value = "a"
+  }
+}
+
+resource "satellite_host" "registered" {
+  name            = "legacy01.example.com"
+  organization_id = 10
+  location_id     = 2
+  managed         = false
+  delete_action   = "unregister"
+}
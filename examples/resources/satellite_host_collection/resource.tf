@@ -11,3 +11,13 @@ resource "satellite_host_collection" "limited_host_collection" {
   max_hosts       = 10
   unlimited_hosts = false
 }
+
+resource "satellite_host_collection" "patch_group" {
+  name            = "Patch Group A"
+  organization_id = 10
+  description     = "Hosts patched in the first maintenance window"
+  host_names = [
+    "web01.example.com",
+    "web02.example.com",
+  ]
+}
@@ -0,0 +1,3 @@
+# Hostgroups can be imported by ID or by title
+terraform import satellite_hostgroup.web 42
+terraform import satellite_hostgroup.web Base/RHEL9/Web
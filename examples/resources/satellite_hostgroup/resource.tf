@@ -0,0 +1,21 @@
+resource "satellite_hostgroup" "base" {
+  name             = "Base"
+  organization_ids = [10]
+  location_ids     = [2]
+}
+
+resource "satellite_hostgroup" "web" {
+  name                     = "Web"
+  parent_id                = satellite_hostgroup.base.id
+  operatingsystem_id       = 3
+  architecture_id          = 1
+  content_view_id          = 12
+  lifecycle_environment_id = 3
+  activation_keys          = ["rhel9-base", "rhel9-web"]
+  ansible_role_ids         = [7]
+
+  parameter {
+    name  = "patch_group"
+    value = "a"
+  }
+}
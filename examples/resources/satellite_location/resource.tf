@@ -8,3 +8,13 @@ resource "satellite_location" "Mos_Eisley" {
   description = "You will never find a more wretched hive of scum and villainy."
   parent_id   = satellite_location.Tatooine.id
 }
+
+resource "satellite_location" "Anchorhead" {
+  name             = "Anchorhead"
+  parent_id        = satellite_location.Tatooine.id
+  organization_ids = [satellite_organization.downstream.id]
+  domain_ids       = [3]
+  subnet_ids       = [5, 6]
+  smart_proxy_ids  = [2]
+  hostgroup_ids    = [satellite_hostgroup.web.id]
+}
@@ -15,3 +15,38 @@ resource "satellite_organization" "foo" {
     ]
   }
 }
+
+resource "satellite_organization" "downstream" {
+  name                  = "downstream"
+  simple_content_access = true
+
+  cdn_configuration {
+    type                                 = "network_sync"
+    url                                  = "https://satellite-upstream.example.com"
+    username                             = "sync-user"
+    password                             = var.upstream_password
+    password_version                     = 1
+    ssl_ca_credential_id                 = 3
+    upstream_organization_label          = "Default_Organization"
+    upstream_content_view_label          = "RHEL9"
+    upstream_lifecycle_environment_label = "Production"
+  }
+}
+
+resource "satellite_organization" "disconnected" {
+  name                  = "disconnected"
+  simple_content_access = true
+
+  cdn_configuration {
+    type = "export_sync"
+  }
+}
+
+resource "satellite_organization" "site" {
+  name            = "site"
+  location_ids    = [satellite_location.Tatooine.id]
+  domain_ids      = [3]
+  subnet_ids      = [5, 6]
+  smart_proxy_ids = [2]
+  user_ids        = [satellite_user.admin.id]
+}
@@ -3,3 +3,9 @@ resource "satellite_role" "my_role" {
   organization_ids = [10]
   description      = "Role granting access for someone to do something in one org"
 }
+
+resource "satellite_role" "org_viewer" {
+  name                 = "Viewer - My Org"
+  clone_from_role_name = "Viewer"
+  organization_ids     = [10]
+}
@@ -0,0 +1,17 @@
+resource "satellite_role_filters" "my_role" {
+  role_id = satellite_role.my_role.id
+
+  filter {
+    resource_type = "Katello::HostCollection"
+    permission_names = [
+      "view_host_collections",
+      "edit_host_collections",
+    ]
+  }
+
+  filter {
+    resource_type    = "Host"
+    permission_names = ["view_hosts"]
+    search           = "hostgroup_fullname ~ Web"
+  }
+}
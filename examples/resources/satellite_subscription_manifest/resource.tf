@@ -2,3 +2,13 @@ resource "satellite_subscription_manifest" "manifest" {
   organization_id = satellite_organization.org.id
   manifest        = rhsm_allocation_manifest.manifest.manifest
 }
+
+resource "satellite_subscription_manifest" "manifest_file" {
+  organization_id = satellite_organization.other_org.id
+  manifest_path   = "${path.module}/manifest.zip"
+  refresh_trigger = "2024-06"
+}
+
+output "manifest_expiration_date" {
+  value = satellite_subscription_manifest.manifest_file.expiration_date
+}
@@ -0,0 +1,12 @@
+resource "satellite_user" "automation" {
+  login                   = "svc-automation"
+  firstname               = "Automation"
+  lastname                = "Service Account"
+  mail                    = "automation@example.com"
+  auth_source_id          = 1
+  password                = var.automation_password
+  password_version        = 1
+  default_organization_id = 10
+  organization_ids        = [10]
+  role_ids                = [satellite_role.my_role.id]
+}
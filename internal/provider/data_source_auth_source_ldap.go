@@ -156,13 +156,17 @@ func dataSourceAuthSourceLDAPRead(ctx context.Context, d *schema.ResourceData, m
 	opt := new(gosatellite.AuthSourceLDAPsListOptions)
 	opt.Search = searchString
 
-	authSourcesResp, _, err := client.AuthSourceLDAPs.List(context.Background(), opt)
+	authSources, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.AuthSourceLDAP, error) {
+		a, _, err := client.AuthSourceLDAPs.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &a.ListResponse, a.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	authSources := *authSourcesResp.Results
-
 	if len(authSources) == 0 {
 		return diag.Errorf("No LDAP Auth Sources found for search string %s", searchString)
 	}
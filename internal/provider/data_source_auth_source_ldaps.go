@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceAuthSourceLDAPs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite LDAP authentication sources that match a search.",
+
+		ReadContext: dataSourceAuthSourceLDAPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A search filter for the LDAP authentication source search. If not set, all LDAP authentication sources are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"auth_source_ldaps": {
+				Description: "A list of objects containing information on the LDAP authentication sources that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_dn": {
+							Description: "The base DN used to search for users.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"host": {
+							Description: "The hostname of the LDAP server.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the LDAP authentication source.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the LDAP authentication source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"onthefly_register": {
+							Description: "Are users created automatically the first time they log in?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"port": {
+							Description: "The port the LDAP server is listening on.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"server_type": {
+							Description: "The type of the LDAP server.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tls": {
+							Description: "Is TLS used to connect to the LDAP server?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"usergroup_sync": {
+							Description: "Are external user groups synced automatically when users log in?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthSourceLDAPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.AuthSourceLDAPsListOptions)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	authSources, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.AuthSourceLDAP, error) {
+		a, _, err := client.AuthSourceLDAPs.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &a.ListResponse, a.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authSourceList := []map[string]interface{}{}
+	for _, x := range authSources {
+		authSource := make(map[string]interface{})
+		authSource["base_dn"] = x.BaseDN
+		authSource["host"] = x.Host
+		authSource["id"] = x.ID
+		authSource["name"] = x.Name
+		authSource["onthefly_register"] = x.OnTheFlyRegister
+		authSource["port"] = x.Port
+		authSource["server_type"] = x.ServerType
+		authSource["tls"] = x.TLS
+		authSource["usergroup_sync"] = x.UserGroupSync
+		authSourceList = append(authSourceList, authSource)
+	}
+
+	d.SetId("-")
+	d.Set("auth_source_ldaps", authSourceList)
+
+	return nil
+}
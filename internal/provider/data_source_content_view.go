@@ -210,13 +210,17 @@ func dataSourceContentViewRead(ctx context.Context, d *schema.ResourceData, meta
 		opt.Without = without
 	}
 
-	cv, _, err := client.ContentViews.List(context.Background(), opt)
+	cvList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.ContentView, error) {
+		c, _, err := client.ContentViews.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &c.ListResponse, c.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	cvList := *cv.Results
-
 	if len(cvList) == 0 {
 		return diag.Errorf("No Content Views found")
 	}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceContentViewVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about a version of a Red Hat Satellite Content View, either by version number or by the Lifecycle Environment it is promoted to.",
+
+		ReadContext: dataSourceContentViewVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_view_id": {
+				Description: "The ID of the Content View the version belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"environment_id": {
+				Description:  "The ID of a Lifecycle Environment. The version of the Content View currently promoted to the environment is returned.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"environment_id", "version"},
+			},
+			"version": {
+				Description:  "The version number of the Content View version, such as `4.0`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"environment_id", "version"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"content_view_name": {
+				Description: "The name of the Content View the version belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"counts": {
+				Description: "A map of the package, module stream and errata counts of the Content View version.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"created_at": {
+				Description: "Timestamp of when the Content View version was published.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description the Content View version was published with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"environments": {
+				Description: "A list of the Lifecycle Environments the Content View version is promoted to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the Lifecycle Environment.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The label of the Lifecycle Environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the Lifecycle Environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"publish_date": {
+							Description: "Timestamp of when the Content View version was promoted to the Lifecycle Environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"environment_ids": {
+				Description: "A list of the IDs of the Lifecycle Environments the Content View version is promoted to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"major": {
+				Description: "The major version number of the Content View version.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"minor": {
+				Description: "The minor version number of the Content View version.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"repositories": {
+				Description: "A list of the repositories contained in the Content View version.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the repository.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The label of the repository.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the repository.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"updated_at": {
+				Description: "Timestamp of when the Content View version was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceContentViewVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	cvID := d.Get("content_view_id").(int)
+
+	opt := new(gosatellite.ContentViewVersionsListOptions)
+	opt.ContentViewID = cvID
+
+	if envID, ok := d.GetOk("environment_id"); ok {
+		opt.EnvironmentID = envID.(int)
+	}
+
+	if version, ok := d.GetOk("version"); ok {
+		opt.Version = version.(string)
+	}
+
+	cvvList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.ContentViewVersion, error) {
+		c, _, err := client.ContentViewVersions.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &c.ListResponse, c.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(cvvList) == 0 {
+		return diag.Errorf("No Content View versions found for Content View %d", cvID)
+	}
+
+	if len(cvvList) > 1 {
+		return diag.Errorf("%d Content View versions found for Content View %d, adjust arguments so only 1 is returned", len(cvvList), cvID)
+	}
+
+	cvv := cvvList[0]
+
+	d.SetId(strconv.Itoa(*cvv.ID))
+
+	counts := make(map[string]interface{})
+	counts["module_streams"] = cvv.ModuleStreamCount
+	counts["packages"] = cvv.PackageCount
+	if cvv.ErrataCounts != nil {
+		counts["errata_bugfix"] = cvv.ErrataCounts.Bugfix
+		counts["errata_enhancement"] = cvv.ErrataCounts.Enhancement
+		counts["errata_security"] = cvv.ErrataCounts.Security
+		counts["errata_total"] = cvv.ErrataCounts.Total
+	}
+
+	environments := []map[string]interface{}{}
+	environmentIDs := []int{}
+	if cvv.Environments != nil {
+		for _, x := range *cvv.Environments {
+			environment := make(map[string]interface{})
+			environment["id"] = x.ID
+			environment["label"] = x.Label
+			environment["name"] = x.Name
+			environment["publish_date"] = x.PublishDate
+			environments = append(environments, environment)
+			environmentIDs = append(environmentIDs, *x.ID)
+		}
+	}
+
+	repositories := []map[string]interface{}{}
+	if cvv.Repositories != nil {
+		for _, x := range *cvv.Repositories {
+			repository := make(map[string]interface{})
+			repository["id"] = x.ID
+			repository["label"] = x.Label
+			repository["name"] = x.Name
+			repositories = append(repositories, repository)
+		}
+	}
+
+	if cvv.ContentView != nil {
+		d.Set("content_view_name", cvv.ContentView.Name)
+	}
+	d.Set("counts", counts)
+	d.Set("created_at", cvv.CreatedAt)
+	d.Set("description", cvv.Description)
+	d.Set("environments", environments)
+	d.Set("environment_ids", environmentIDs)
+	d.Set("major", cvv.Major)
+	d.Set("minor", cvv.Minor)
+	d.Set("repositories", repositories)
+	d.Set("updated_at", cvv.UpdatedAt)
+	d.Set("version", cvv.Version)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceContentViewVersion(t *testing.T) {
+	t.Skip("data source not yet implemented, remove this once you add your own code")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceContentViewVersion,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.scaffolding_data_source.foo", "sample_attribute", regexp.MustCompile("^ba")),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceContentViewVersion = `
+data "scaffolding_data_source" "foo" {
+  sample_attribute = "bar"
+}
+`
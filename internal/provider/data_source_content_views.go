@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceContentViews() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite content views that match a search.",
+
+		ReadContext: dataSourceContentViewsRead,
+
+		Schema: map[string]*schema.Schema{
+			"composite": {
+				Description: "If set to true, only composite content views are returned.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"environment_id": {
+				Description: "The ID of a lifecycle environment to filter the content view search on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"nondefault": {
+				Description: "If set to true, the Default Organization View of the organization is not returned.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"organization_id": {
+				Description: "The ID of an organization to filter the content view search on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"search": {
+				Description: "A search filter for the content view search. If not set, all content views are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"content_views": {
+				Description: "A list of objects containing information on the content views that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"composite": {
+							Description: "Is the content view a composite content view?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"default": {
+							Description: "Is the content view the Default Organization View?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"description": {
+							Description: "A description of the content view.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"environment_ids": {
+							Description: "A list of IDs of the lifecycle environments the content view is promoted to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"id": {
+							Description: "The ID of the content view.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The label of the content view.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_published": {
+							Description: "Timestamp of when the content view was last published.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"latest_version": {
+							Description: "The latest version of the content view.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the content view.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"organization_id": {
+							Description: "The ID of the organization of the content view.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"repository_ids": {
+							Description: "A list of IDs of the repositories in the content view.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceContentViewsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.ContentViewsListOptions)
+
+	if c, ok := d.GetOk("composite"); ok {
+		opt.Composite = c.(bool)
+	}
+
+	if e, ok := d.GetOk("environment_id"); ok {
+		opt.EnvironmentID = e.(int)
+	}
+
+	if nd, ok := d.GetOk("nondefault"); ok {
+		opt.Nondefault = nd.(bool)
+	}
+
+	if o, ok := d.GetOk("organization_id"); ok {
+		opt.OrganizationID = o.(int)
+	}
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	cvs, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.ContentView, error) {
+		c, _, err := client.ContentViews.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &c.ListResponse, c.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cvList := []map[string]interface{}{}
+	for _, x := range cvs {
+		environmentIDs := []int{}
+		if x.Environments != nil {
+			for _, e := range *x.Environments {
+				environmentIDs = append(environmentIDs, *e.ID)
+			}
+		}
+
+		cv := make(map[string]interface{})
+		cv["composite"] = x.Composite
+		cv["default"] = x.Default
+		cv["description"] = x.Description
+		cv["environment_ids"] = environmentIDs
+		cv["id"] = x.ID
+		cv["label"] = x.Label
+		cv["last_published"] = x.LastPublished
+		cv["latest_version"] = x.LatestVersion
+		cv["name"] = x.Name
+		cv["organization_id"] = x.OrganizationID
+		cv["repository_ids"] = x.RepositoryIDs
+		cvList = append(cvList, cv)
+	}
+
+	d.SetId("-")
+	d.Set("content_views", cvList)
+
+	return nil
+}
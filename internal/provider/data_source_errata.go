@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+var erratumDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func dataSourceErrata() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about Red Hat Satellite errata and the number of hosts they apply to.",
+
+		ReadContext: dataSourceErrataRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_view_version_id": {
+				Description:  "The ID of a content view version to filter the errata search on. At least one of `content_view_version_id`, `environment_id` or `organization_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"content_view_version_id", "environment_id", "organization_id"},
+			},
+			"cve": {
+				Description: "A CVE ID, such as `CVE-2023-4911`, to filter the errata search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"environment_id": {
+				Description:  "The ID of a lifecycle environment to filter the errata search on. At least one of `content_view_version_id`, `environment_id` or `organization_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"content_view_version_id", "environment_id", "organization_id"},
+			},
+			"issued_after": {
+				Description:  "Only return errata issued on or after this date. The date must be in the format `YYYY-MM-DD`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(erratumDateRegexp, "must be a date in the format YYYY-MM-DD"),
+			},
+			"issued_before": {
+				Description:  "Only return errata issued on or before this date. The date must be in the format `YYYY-MM-DD`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(erratumDateRegexp, "must be a date in the format YYYY-MM-DD"),
+			},
+			"organization_id": {
+				Description:  "The ID of an organization to filter the errata search on. At least one of `content_view_version_id`, `environment_id` or `organization_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"content_view_version_id", "environment_id", "organization_id"},
+			},
+			"search": {
+				Description: "An additional search filter for the errata search, such as `reboot_suggested = true`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"severity": {
+				Description:  "The severity of the errata to filter the errata search on. Valid values are `Critical`, `Important`, `Moderate` and `Low`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Critical", "Important", "Moderate", "Low"}, false),
+			},
+			"type": {
+				Description:  "The type of the errata to filter the errata search on. Valid values are `security`, `bugfix` and `enhancement`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"security", "bugfix", "enhancement"}, false),
+			},
+			"errata": {
+				Description: "A list of objects containing information on the errata that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cves": {
+							Description: "A list of the CVE IDs fixed by the erratum.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"errata_id": {
+							Description: "The errata ID of the erratum, such as `RHSA-2023:5455`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hosts_applicable_count": {
+							Description: "The number of hosts the erratum is applicable to.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"hosts_installable_count": {
+							Description: "The number of hosts the erratum can be installed on with the content available to them.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the erratum.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"issued": {
+							Description: "The date the erratum was issued.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reboot_suggested": {
+							Description: "Is a reboot suggested after installing the erratum?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"severity": {
+							Description: "The severity of the erratum.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the erratum.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the erratum.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated": {
+							Description: "The date the erratum was last updated.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceErrataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.ErrataListOptions)
+
+	if cvv, ok := d.GetOk("content_view_version_id"); ok {
+		opt.ContentViewVersionID = cvv.(int)
+	}
+
+	if e, ok := d.GetOk("environment_id"); ok {
+		opt.EnvironmentID = e.(int)
+	}
+
+	if o, ok := d.GetOk("organization_id"); ok {
+		opt.OrganizationID = o.(int)
+	}
+
+	opt.Search = erratumSearch(d)
+
+	errata, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Erratum, error) {
+		e, _, err := client.Errata.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &e.ListResponse, e.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errataList := []map[string]interface{}{}
+	for _, x := range errata {
+		cves := []string{}
+		if x.CVEs != nil {
+			for _, c := range *x.CVEs {
+				cves = append(cves, *c.CVEID)
+			}
+		}
+
+		erratum := make(map[string]interface{})
+		erratum["cves"] = cves
+		erratum["errata_id"] = x.ErrataID
+		erratum["hosts_applicable_count"] = x.HostsApplicableCount
+		erratum["hosts_installable_count"] = x.HostsAvailableCount
+		erratum["id"] = x.ID
+		erratum["issued"] = x.Issued
+		erratum["reboot_suggested"] = x.RebootSuggested
+		erratum["severity"] = x.Severity
+		erratum["title"] = x.Title
+		erratum["type"] = x.Type
+		erratum["updated"] = x.Updated
+		errataList = append(errataList, erratum)
+	}
+
+	d.SetId("-")
+	d.Set("errata", errataList)
+
+	return nil
+}
+
+// erratumSearch combines the errata filter arguments and the search argument into
+// a single search string.
+func erratumSearch(d *schema.ResourceData) string {
+	terms := []string{}
+
+	if t, ok := d.GetOk("type"); ok {
+		terms = append(terms, fmt.Sprintf("type = %s", t.(string)))
+	}
+
+	if s, ok := d.GetOk("severity"); ok {
+		terms = append(terms, fmt.Sprintf("severity = %s", s.(string)))
+	}
+
+	if c, ok := d.GetOk("cve"); ok {
+		terms = append(terms, fmt.Sprintf("cve = \"%s\"", c.(string)))
+	}
+
+	if ia, ok := d.GetOk("issued_after"); ok {
+		terms = append(terms, fmt.Sprintf("issued >= \"%s\"", ia.(string)))
+	}
+
+	if ib, ok := d.GetOk("issued_before"); ok {
+		terms = append(terms, fmt.Sprintf("issued <= \"%s\"", ib.(string)))
+	}
+
+	if s, ok := d.GetOk("search"); ok {
+		terms = append(terms, fmt.Sprintf("(%s)", s.(string)))
+	}
+
+	return strings.Join(terms, " and ")
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceHost() *schema.Resource {
+	hostSchema := dataSourceHostAttributes()
+
+	hostSchema["search"] = &schema.Schema{
+		Description:  "A search filter for the host search, such as `name=web01.example.com`. The search must only return 1 host.",
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+	hostSchema["facts"] = &schema.Schema{
+		Description: "A map of the facts reported by the host, such as `network::fqdn`.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Data source to access information about a Red Hat Satellite host.",
+
+		ReadContext: dataSourceHostRead,
+
+		Schema: hostSchema,
+	}
+}
+
+func dataSourceHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	searchString := d.Get("search").(string)
+
+	opt := new(gosatellite.HostsListOptions)
+	opt.Search = searchString
+
+	hostList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Host, error) {
+		h, _, err := client.Hosts.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &h.ListResponse, h.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(hostList) == 0 {
+		return diag.Errorf("No hosts found for search string %s", searchString)
+	}
+
+	if len(hostList) > 1 {
+		return diag.Errorf("%d hosts found for search string %s", len(hostList), searchString)
+	}
+
+	// the host list does not include all attributes, so get the host itself
+	host, _, err := client.Hosts.Get(context.Background(), *hostList[0].ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	facts, _, err := client.Hosts.Facts(context.Background(), *host.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*host.ID))
+
+	for k, v := range flattenHost(host) {
+		if k == "id" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	d.Set("facts", facts)
+
+	return nil
+}
+
+// dataSourceHostAttributes returns the computed attributes of a host that are shared by
+// the satellite_host and satellite_hosts data sources.
+func dataSourceHostAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"applicable_package_count": {
+			Description: "The number of package updates applicable to the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"architecture_name": {
+			Description: "The name of the architecture of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"comment": {
+			Description: "Additional information about the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"content_source_name": {
+			Description: "The name of the Capsule that provides content to the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"content_view_id": {
+			Description: "The ID of the content view of the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"content_view_name": {
+			Description: "The name of the content view of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "Timestamp of when the host was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"domain_name": {
+			Description: "The name of the domain of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"errata_bugfix_count": {
+			Description: "The number of bug fix errata applicable to the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"errata_enhancement_count": {
+			Description: "The number of enhancement errata applicable to the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"errata_security_count": {
+			Description: "The number of security errata applicable to the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"errata_total_count": {
+			Description: "The total number of errata applicable to the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"global_status_label": {
+			Description: "The global status of the host, such as `OK`, `Warning` or `Error`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"hostgroup_id": {
+			Description: "The ID of the hostgroup of the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"hostgroup_name": {
+			Description: "The name of the hostgroup of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"hostgroup_title": {
+			Description: "The title of the hostgroup of the host, including the names of its parent hostgroups.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ip": {
+			Description: "The IPv4 address of the primary interface of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"lifecycle_environment_id": {
+			Description: "The ID of the lifecycle environment of the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"lifecycle_environment_name": {
+			Description: "The name of the lifecycle environment of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"location_id": {
+			Description: "The ID of the location of the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"location_name": {
+			Description: "The name of the location of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"mac": {
+			Description: "The MAC address of the primary interface of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"operatingsystem_id": {
+			Description: "The ID of the operating system of the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"operatingsystem_name": {
+			Description: "The name of the operating system of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"organization_id": {
+			Description: "The ID of the organization of the host.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"organization_name": {
+			Description: "The name of the organization of the host.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subscription_status": {
+			Description: "The subscription status of the host as a number.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"subscription_status_label": {
+			Description: "The subscription status of the host, such as `Fully entitled` or `Simple Content Access`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "Timestamp of when the host was last updated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"upgradable_package_count": {
+			Description: "The number of packages on the host that can be upgraded from its content view and lifecycle environment.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
+
+// flattenHost converts a host to a map of the attributes in dataSourceHostAttributes,
+// plus its ID.
+func flattenHost(host *gosatellite.Host) map[string]interface{} {
+	h := make(map[string]interface{})
+	h["id"] = host.ID
+	h["architecture_name"] = host.ArchitectureName
+	h["comment"] = host.Comment
+	h["created_at"] = host.CreatedAt
+	h["domain_name"] = host.DomainName
+	h["global_status_label"] = host.GlobalStatusLabel
+	h["hostgroup_id"] = host.HostgroupID
+	h["hostgroup_name"] = host.HostgroupName
+	h["hostgroup_title"] = host.HostgroupTitle
+	h["ip"] = host.IP
+	h["location_id"] = host.LocationID
+	h["location_name"] = host.LocationName
+	h["mac"] = host.MAC
+	h["name"] = host.Name
+	h["operatingsystem_id"] = host.OperatingsystemID
+	h["operatingsystem_name"] = host.OperatingsystemName
+	h["organization_id"] = host.OrganizationID
+	h["organization_name"] = host.OrganizationName
+	h["subscription_status"] = host.SubscriptionStatus
+	h["subscription_status_label"] = host.SubscriptionStatusLabel
+	h["updated_at"] = host.UpdatedAt
+
+	if cf := host.ContentFacetAttributes; cf != nil {
+		h["applicable_package_count"] = cf.ApplicablePackageCount
+		h["content_source_name"] = cf.ContentSourceName
+		h["content_view_id"] = cf.ContentViewID
+		h["content_view_name"] = cf.ContentViewName
+		h["lifecycle_environment_id"] = cf.LifecycleEnvironmentID
+		h["lifecycle_environment_name"] = cf.LifecycleEnvironmentName
+		h["upgradable_package_count"] = cf.UpgradablePackageCount
+
+		if cf.ErrataCounts != nil {
+			h["errata_bugfix_count"] = cf.ErrataCounts.Bugfix
+			h["errata_enhancement_count"] = cf.ErrataCounts.Enhancement
+			h["errata_security_count"] = cf.ErrataCounts.Security
+			h["errata_total_count"] = cf.ErrataCounts.Total
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceHostgroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about a Red Hat Satellite hostgroup.",
+
+		ReadContext: dataSourceHostgroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description:  "A search filter for the hostgroup search, such as `title=Base/RHEL9/Web`. The search must only return 1 hostgroup.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"activation_keys": {
+				Description: "A list of names of activation keys used to register hosts of the hostgroup.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ansible_role_ids": {
+				Description: "A list of IDs of Ansible roles assigned to the hostgroup.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"architecture_id": {
+				Description: "The ID of the architecture of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"content_source_id": {
+				Description: "The ID of the Capsule that provides content to hosts of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"content_view_id": {
+				Description: "The ID of the content view of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Timestamp of when the hostgroup was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "A description of the hostgroup.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"domain_id": {
+				Description: "The ID of the domain of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"lifecycle_environment_id": {
+				Description: "The ID of the lifecycle environment of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"location_ids": {
+				Description: "A list of IDs of locations the hostgroup is available in.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"medium_id": {
+				Description: "The ID of the installation medium of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the hostgroup.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"operatingsystem_id": {
+				Description: "The ID of the operating system of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"organization_ids": {
+				Description: "A list of IDs of organizations the hostgroup is available in.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"parameters": {
+				Description: "A map of the names and values of the parameters set on the hostgroup.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"parent_id": {
+				Description: "The ID of the parent hostgroup. If not set, the hostgroup is a top level hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"ptable_id": {
+				Description: "The ID of the partition table of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"subnet_id": {
+				Description: "The ID of the IPv4 subnet of the hostgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"title": {
+				Description: "The title of the hostgroup, which is its name prefixed with the names of its parent hostgroups.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Timestamp of when the hostgroup was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceHostgroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	searchString := d.Get("search").(string)
+
+	opt := new(gosatellite.HostgroupsListOptions)
+	opt.Search = searchString
+
+	hostgroupList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Hostgroup, error) {
+		h, _, err := client.Hostgroups.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &h.ListResponse, h.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(hostgroupList) == 0 {
+		return diag.Errorf("No hostgroups found for search string %s", searchString)
+	}
+
+	if len(hostgroupList) > 1 {
+		return diag.Errorf("%d hostgroups found for search string %s", len(hostgroupList), searchString)
+	}
+
+	// the hostgroup list does not include parameters or taxonomies, so get the hostgroup itself
+	hostgroup, _, err := client.Hostgroups.Get(context.Background(), *hostgroupList[0].ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*hostgroup.ID))
+	d.Set("architecture_id", hostgroup.ArchitectureID)
+	d.Set("content_source_id", hostgroup.ContentSourceID)
+	d.Set("content_view_id", hostgroup.ContentViewID)
+	d.Set("created_at", hostgroup.CreatedAt)
+	d.Set("description", hostgroup.Description)
+	d.Set("domain_id", hostgroup.DomainID)
+	d.Set("lifecycle_environment_id", hostgroup.LifecycleEnvironmentID)
+	d.Set("medium_id", hostgroup.MediumID)
+	d.Set("name", hostgroup.Name)
+	d.Set("operatingsystem_id", hostgroup.OperatingsystemID)
+	d.Set("parent_id", hostgroup.ParentID)
+	d.Set("ptable_id", hostgroup.PtableID)
+	d.Set("subnet_id", hostgroup.SubnetID)
+	d.Set("title", hostgroup.Title)
+	d.Set("updated_at", hostgroup.UpdatedAt)
+
+	ansibleRoleIDs := []int{}
+	if hostgroup.AnsibleRoles != nil {
+		for _, x := range *hostgroup.AnsibleRoles {
+			ansibleRoleIDs = append(ansibleRoleIDs, *x.ID)
+		}
+	}
+	d.Set("ansible_role_ids", ansibleRoleIDs)
+
+	locationIDs := []int{}
+	for _, x := range *hostgroup.Locations {
+		locationIDs = append(locationIDs, *x.ID)
+	}
+	d.Set("location_ids", locationIDs)
+
+	organizationIDs := []int{}
+	for _, x := range *hostgroup.Organizations {
+		organizationIDs = append(organizationIDs, *x.ID)
+	}
+	d.Set("organization_ids", organizationIDs)
+
+	activationKeys := []string{}
+	parameters := make(map[string]string)
+	if hostgroup.Parameters != nil {
+		for _, x := range *hostgroup.Parameters {
+			parameters[*x.Name] = *x.Value
+
+			if *x.Name == activationKeysParameter {
+				for _, key := range strings.Split(*x.Value, ",") {
+					if key = strings.TrimSpace(key); key != "" {
+						activationKeys = append(activationKeys, key)
+					}
+				}
+			}
+		}
+	}
+	d.Set("activation_keys", activationKeys)
+	d.Set("parameters", parameters)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceHosts() *schema.Resource {
+	hostSchema := dataSourceHostAttributes()
+
+	hostSchema["id"] = &schema.Schema{
+		Description: "The ID of the host.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite hosts that match a search.",
+
+		ReadContext: dataSourceHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A search filter for the host search, such as `hostgroup_title=Base/RHEL9/Web`. If not set, all hosts are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"hosts": {
+				Description: "A list of objects containing information on the hosts that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: hostSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.HostsListOptions)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	hosts, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Host, error) {
+		h, _, err := client.Hosts.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &h.ListResponse, h.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostList := []map[string]interface{}{}
+	for x := range hosts {
+		hostList = append(hostList, flattenHost(&hosts[x]))
+	}
+
+	d.SetId("-")
+	d.Set("hosts", hostList)
+
+	return nil
+}
@@ -129,13 +129,17 @@ func dataSourceLifecycleEnvironmentRead(ctx context.Context, d *schema.ResourceD
 		opt.Search = search.(string)
 	}
 
-	le, _, err := client.LifecycleEnvironments.List(context.Background(), opt)
+	leList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.LifecycleEnvironment, error) {
+		l, _, err := client.LifecycleEnvironments.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &l.ListResponse, l.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	leList := *le.Results
-
 	if len(leList) == 0 {
 		return diag.Errorf("No Lifecyle Environment found")
 	}
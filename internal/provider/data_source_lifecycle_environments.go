@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceLifecycleEnvironments() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite lifecycle environments that match a search.",
+
+		ReadContext: dataSourceLifecycleEnvironmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Description: "The ID of an organization to filter the lifecycle environment search on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"search": {
+				Description: "A search filter for the lifecycle environment search. If not set, all lifecycle environments are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"lifecycle_environments": {
+				Description: "A list of objects containing information on the lifecycle environments that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Description: "A description of the lifecycle environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the lifecycle environment.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The label of the lifecycle environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"library": {
+							Description: "Is the lifecycle environment the Library environment?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the lifecycle environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"organization_id": {
+							Description: "The ID of the organization of the lifecycle environment.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"prior_id": {
+							Description: "The ID of the lifecycle environment before this one in the path.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"prior_name": {
+							Description: "The name of the lifecycle environment before this one in the path.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLifecycleEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.LifecycleEnvironmentsListOptions)
+
+	if o, ok := d.GetOk("organization_id"); ok {
+		opt.OrganizationID = o.(int)
+	}
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	les, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.LifecycleEnvironment, error) {
+		l, _, err := client.LifecycleEnvironments.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &l.ListResponse, l.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	leList := []map[string]interface{}{}
+	for _, x := range les {
+		le := make(map[string]interface{})
+		le["description"] = x.Description
+		le["id"] = x.ID
+		le["label"] = x.Label
+		le["library"] = x.Library
+		le["name"] = x.Name
+		le["organization_id"] = x.OrganizationID
+		if x.Prior != nil {
+			le["prior_id"] = x.Prior.ID
+			le["prior_name"] = x.Prior.Name
+		}
+		leList = append(leList, le)
+	}
+
+	d.SetId("-")
+	d.Set("lifecycle_environments", leList)
+
+	return nil
+}
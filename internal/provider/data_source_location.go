@@ -70,13 +70,17 @@ func dataSourceLocationRead(ctx context.Context, d *schema.ResourceData, meta in
 	locSearch := new(gosatellite.LocationsListOptions)
 	locSearch.Search = searchString
 
-	location, _, err := client.Locations.List(context.Background(), locSearch)
+	locationList, err := listAllPages(&locSearch.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Location, error) {
+		l, _, err := client.Locations.List(context.Background(), locSearch)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &l.ListResponse, l.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	locationList := *location.Results
-
 	if len(locationList) == 0 {
 		return diag.Errorf("No locations found for search string %s", searchString)
 	}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceLocations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite locations that match a search.",
+
+		ReadContext: dataSourceLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A search filter for the location search. If not set, all locations are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"locations": {
+				Description: "A list of objects containing information on the locations that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Description: "Timestamp of when the location was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "A description of the location.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the location.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the location.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"parent_id": {
+							Description: "The ID of the parent for this location.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"parent_name": {
+							Description: "The name of the parent for this location.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the location.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "Timestamp of when the location was last updated.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.LocationsListOptions)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	locations, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Location, error) {
+		l, _, err := client.Locations.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &l.ListResponse, l.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	locationList := []map[string]interface{}{}
+	for _, x := range locations {
+		location := make(map[string]interface{})
+		location["created_at"] = x.CreatedAt
+		location["description"] = x.Description
+		location["id"] = x.ID
+		location["name"] = x.Name
+		location["parent_id"] = x.ParentID
+		location["parent_name"] = x.ParentName
+		location["title"] = x.Title
+		location["updated_at"] = x.UpdatedAt
+		locationList = append(locationList, location)
+	}
+
+	d.SetId("-")
+	d.Set("locations", locationList)
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceModuleStreams() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about the module streams in a Red Hat Satellite repository, content view version or lifecycle environment.",
+
+		ReadContext: dataSourceModuleStreamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arch": {
+				Description: "The architecture of the module streams to filter the module stream search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"content_view_version_id": {
+				Description:  "The ID of a content view version to filter the module stream search on. Exactly one of `content_view_version_id`, `environment_id` or `repository_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"content_view_version_id", "environment_id", "repository_id"},
+			},
+			"environment_id": {
+				Description:  "The ID of a lifecycle environment to filter the module stream search on. Exactly one of `content_view_version_id`, `environment_id` or `repository_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"content_view_version_id", "environment_id", "repository_id"},
+			},
+			"name": {
+				Description: "The name of the module streams to filter the module stream search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"organization_id": {
+				Description: "The ID of an organization to filter the module stream search on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"repository_id": {
+				Description:  "The ID of a repository to filter the module stream search on. Exactly one of `content_view_version_id`, `environment_id` or `repository_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"content_view_version_id", "environment_id", "repository_id"},
+			},
+			"search": {
+				Description: "An additional search filter for the module stream search.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"stream": {
+				Description: "The stream of the module streams to filter the module stream search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"module_streams": {
+				Description: "A list of objects containing information on the module streams that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arch": {
+							Description: "The architecture of the module stream.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"context": {
+							Description: "The context of the module stream.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the module stream.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the module.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"stream": {
+							Description: "The stream of the module.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"summary": {
+							Description: "A summary of the module stream.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"uuid": {
+							Description: "The UUID of the module stream.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "The version of the module stream.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceModuleStreamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.ModuleStreamsListOptions)
+
+	if cvv, ok := d.GetOk("content_view_version_id"); ok {
+		opt.ContentViewVersionID = cvv.(int)
+	}
+
+	if e, ok := d.GetOk("environment_id"); ok {
+		opt.EnvironmentID = e.(int)
+	}
+
+	if o, ok := d.GetOk("organization_id"); ok {
+		opt.OrganizationID = o.(int)
+	}
+
+	if r, ok := d.GetOk("repository_id"); ok {
+		opt.RepositoryID = r.(int)
+	}
+
+	opt.Search = contentUnitSearch(d, []string{"name", "stream", "arch"})
+
+	moduleStreams, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.ModuleStream, error) {
+		m, _, err := client.ModuleStreams.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &m.ListResponse, m.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	moduleStreamList := []map[string]interface{}{}
+	for _, x := range moduleStreams {
+		moduleStream := make(map[string]interface{})
+		moduleStream["arch"] = x.Arch
+		moduleStream["context"] = x.Context
+		moduleStream["id"] = x.ID
+		moduleStream["name"] = x.Name
+		moduleStream["stream"] = x.Stream
+		moduleStream["summary"] = x.Summary
+		moduleStream["uuid"] = x.UUID
+		moduleStream["version"] = x.Version
+		moduleStreamList = append(moduleStreamList, moduleStream)
+	}
+
+	d.SetId("-")
+	d.Set("module_streams", moduleStreamList)
+
+	return nil
+}
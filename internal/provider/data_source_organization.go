@@ -65,13 +65,17 @@ func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, met
 	opt := new(gosatellite.OrganizationsListOptions)
 	opt.Search = searchString
 
-	org, _, err := client.Organizations.List(context.Background(), opt)
+	orgList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Organization, error) {
+		o, _, err := client.Organizations.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &o.ListResponse, o.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	orgList := *org.Results
-
 	if len(orgList) == 0 {
 		return diag.Errorf("No organizations found for search string %s", searchString)
 	}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceOrganizations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite organizations that match a search.",
+
+		ReadContext: dataSourceOrganizationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A search filter for the organization search. If not set, all organizations are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"organizations": {
+				Description: "A list of objects containing information on the organizations that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Description: "Timestamp of when the organization was created.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the organization.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The label of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the organization.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "Timestamp of when the organization was last updated.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.OrganizationsListOptions)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	orgs, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Organization, error) {
+		o, _, err := client.Organizations.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &o.ListResponse, o.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orgList := []map[string]interface{}{}
+	for _, x := range orgs {
+		org := make(map[string]interface{})
+		org["created_at"] = x.CreatedAt
+		org["description"] = x.Description
+		org["id"] = x.ID
+		org["label"] = x.Label
+		org["name"] = x.Name
+		org["title"] = x.Title
+		org["updated_at"] = x.UpdatedAt
+		orgList = append(orgList, org)
+	}
+
+	d.SetId("-")
+	d.Set("organizations", orgList)
+
+	return nil
+}
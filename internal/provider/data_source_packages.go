@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourcePackages() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about the packages in a Red Hat Satellite repository, content view version or lifecycle environment.",
+
+		ReadContext: dataSourcePackagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arch": {
+				Description: "The architecture of the packages to filter the package search on, such as `x86_64` or `noarch`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"content_view_version_id": {
+				Description:  "The ID of a content view version to filter the package search on. Exactly one of `content_view_version_id`, `environment_id` or `repository_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"content_view_version_id", "environment_id", "repository_id"},
+			},
+			"environment_id": {
+				Description:  "The ID of a lifecycle environment to filter the package search on. Exactly one of `content_view_version_id`, `environment_id` or `repository_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"content_view_version_id", "environment_id", "repository_id"},
+			},
+			"name": {
+				Description: "The name of the packages to filter the package search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"organization_id": {
+				Description: "The ID of an organization to filter the package search on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"release": {
+				Description: "The release of the packages to filter the package search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"repository_id": {
+				Description:  "The ID of a repository to filter the package search on. Exactly one of `content_view_version_id`, `environment_id` or `repository_id` must be set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"content_view_version_id", "environment_id", "repository_id"},
+			},
+			"search": {
+				Description: "An additional search filter for the package search, such as `version > 2.0`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"version": {
+				Description: "The version of the packages to filter the package search on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"packages": {
+				Description: "A list of objects containing information on the packages that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arch": {
+							Description: "The architecture of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"checksum": {
+							Description: "The checksum of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"epoch": {
+							Description: "The epoch of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"filename": {
+							Description: "The file name of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the package.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"nvra": {
+							Description: "The name, version, release and architecture of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"nvrea": {
+							Description: "The name, version, release, epoch and architecture of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"release": {
+							Description: "The release of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"source_rpm": {
+							Description: "The source RPM the package was built from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"summary": {
+							Description: "A summary of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "The version of the package.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.PackagesListOptions)
+
+	if cvv, ok := d.GetOk("content_view_version_id"); ok {
+		opt.ContentViewVersionID = cvv.(int)
+	}
+
+	if e, ok := d.GetOk("environment_id"); ok {
+		opt.EnvironmentID = e.(int)
+	}
+
+	if o, ok := d.GetOk("organization_id"); ok {
+		opt.OrganizationID = o.(int)
+	}
+
+	if r, ok := d.GetOk("repository_id"); ok {
+		opt.RepositoryID = r.(int)
+	}
+
+	opt.Search = contentUnitSearch(d, []string{"name", "version", "release", "arch"})
+
+	packages, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Package, error) {
+		p, _, err := client.Packages.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &p.ListResponse, p.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	packageList := []map[string]interface{}{}
+	for _, x := range packages {
+		pkg := make(map[string]interface{})
+		pkg["arch"] = x.Arch
+		pkg["checksum"] = x.Checksum
+		pkg["epoch"] = x.Epoch
+		pkg["filename"] = x.Filename
+		pkg["id"] = x.ID
+		pkg["name"] = x.Name
+		pkg["nvra"] = x.NVRA
+		pkg["nvrea"] = x.NVREA
+		pkg["release"] = x.Release
+		pkg["source_rpm"] = x.SourceRPM
+		pkg["summary"] = x.Summary
+		pkg["version"] = x.Version
+		packageList = append(packageList, pkg)
+	}
+
+	d.SetId("-")
+	d.Set("packages", packageList)
+
+	return nil
+}
+
+// contentUnitSearch combines the given filter arguments, each of which is named after
+// the search field it matches, and the search argument into a single search string.
+func contentUnitSearch(d *schema.ResourceData, fields []string) string {
+	terms := []string{}
+
+	for _, field := range fields {
+		if v, ok := d.GetOk(field); ok {
+			terms = append(terms, fmt.Sprintf("%s = \"%s\"", field, v.(string)))
+		}
+	}
+
+	if s, ok := d.GetOk("search"); ok {
+		terms = append(terms, fmt.Sprintf("(%s)", s.(string)))
+	}
+
+	return strings.Join(terms, " and ")
+}
@@ -56,16 +56,22 @@ func dataSourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta
 		searchOpt.Search = n.(string)
 	}
 
-	perms, _, err := client.Permissions.List(context.Background(), *searchOpt)
+	perms, err := listAllPages(&searchOpt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Permission, error) {
+		p, _, err := client.Permissions.List(context.Background(), *searchOpt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &p.ListResponse, p.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId("-")
 
-	permList := make([]map[string]interface{}, 0, len(*perms.Results))
+	permList := make([]map[string]interface{}, 0, len(perms))
 
-	for _, x := range *perms.Results {
+	for _, x := range perms {
 		perm := map[string]interface{}{
 			"id":            x.ID,
 			"name":          x.Name,
@@ -110,7 +110,13 @@ func dataSourceProductsRead(ctx context.Context, d *schema.ResourceData, meta in
 		pOptions.Name = pName.(string)
 	}
 
-	products, _, err := client.Products.List(context.Background(), pOptions)
+	products, err := listAllPages(&pOptions.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Product, error) {
+		p, _, err := client.Products.List(context.Background(), pOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &p.ListResponse, p.Results, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -118,7 +124,7 @@ func dataSourceProductsRead(ctx context.Context, d *schema.ResourceData, meta in
 	//d.SetId(strconv.Itoa(orgID))
 
 	productList := []map[string]interface{}{}
-	for _, product := range *products.Results {
+	for _, product := range products {
 		prod := map[string]interface{}{}
 		prod["cp_id"] = product.CpID
 		prod["description"] = product.Description
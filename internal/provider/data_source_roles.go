@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite roles that match a search.",
+
+		ReadContext: dataSourceRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A search filter for the role search, such as `builtin=false`. If not set, all roles are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"roles": {
+				Description: "A list of objects containing information on the roles that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"builtin": {
+							Description: "A number that indicates if the role is a default/builtin role.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"cloned_from_id": {
+							Description: "The ID of the role the role was cloned from.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"description": {
+							Description: "A description of the role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the role.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"origin": {
+							Description: "The origin of the role, such as the plugin that provides it.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.RolesListOptions)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	roles, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Role, error) {
+		r, _, err := client.Roles.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &r.ListResponse, r.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleList := []map[string]interface{}{}
+	for _, x := range roles {
+		role := make(map[string]interface{})
+		role["builtin"] = x.Builtin
+		role["cloned_from_id"] = x.ClonedFromID
+		role["description"] = x.Description
+		role["id"] = x.ID
+		role["name"] = x.Name
+		role["origin"] = x.Origin
+		roleList = append(roleList, role)
+	}
+
+	d.SetId("-")
+	d.Set("roles", roleList)
+
+	return nil
+}
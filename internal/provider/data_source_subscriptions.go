@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about the subscriptions of a Red Hat Satellite organization.",
+
+		ReadContext: dataSourceSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Description: "The ID of the organization to list the subscriptions of.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"search": {
+				Description: "A search filter for the subscription search, such as `name ~ \"Red Hat Enterprise Linux\"`. If not set, all subscriptions of the organization are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"subscriptions": {
+				Description: "A list of objects containing information on the subscriptions that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_number": {
+							Description: "The Red Hat account number the subscription belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"available": {
+							Description: "The number of entitlements of the subscription that are available. A value of `-1` means the subscription is unlimited.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"consumed": {
+							Description: "The number of entitlements of the subscription that are consumed.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"contract_number": {
+							Description: "The contract number of the subscription.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_date": {
+							Description: "Timestamp of when the subscription expires.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the subscription. This is the ID used to attach the subscription to an activation key.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"multi_entitlement": {
+							Description: "Can more than one entitlement of the subscription be attached to a host?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The product name of the subscription.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"pool_id": {
+							Description: "The Candlepin pool ID of the subscription.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"quantity": {
+							Description: "The total number of entitlements of the subscription. A value of `-1` means the subscription is unlimited.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"sku": {
+							Description: "The SKU of the subscription.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start_date": {
+							Description: "Timestamp of when the subscription starts.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the subscription pool, such as `NORMAL` or `STACK_DERIVED`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"upstream_pool_id": {
+							Description: "The ID of the pool in Red Hat Subscription Management the subscription was allocated from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"virt_only": {
+							Description: "Can the subscription only be attached to virtual machines?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"virt_who": {
+							Description: "Does the subscription require virt-who to report the hypervisor of the host?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.SubscriptionsListOptions)
+	opt.OrganizationID = d.Get("organization_id").(int)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	subscriptions, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Subscription, error) {
+		s, _, err := client.Subscriptions.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &s.ListResponse, s.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subscriptionList := []map[string]interface{}{}
+	for _, x := range subscriptions {
+		subscription := make(map[string]interface{})
+		subscription["account_number"] = x.AccountNumber
+		subscription["available"] = x.Available
+		subscription["consumed"] = x.Consumed
+		subscription["contract_number"] = x.ContractNumber
+		subscription["end_date"] = x.EndDate
+		subscription["id"] = x.ID
+		subscription["multi_entitlement"] = x.MultiEntitlement
+		subscription["name"] = x.Name
+		subscription["pool_id"] = x.CpID
+		subscription["quantity"] = x.Quantity
+		subscription["sku"] = x.ProductID
+		subscription["start_date"] = x.StartDate
+		subscription["type"] = x.Type
+		subscription["upstream_pool_id"] = x.UpstreamPoolID
+		subscription["virt_only"] = x.VirtOnly
+		subscription["virt_who"] = x.VirtWho
+		subscriptionList = append(subscriptionList, subscription)
+	}
+
+	d.SetId("-")
+	d.Set("subscriptions", subscriptionList)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source to access information about all Red Hat Satellite users that match a search.",
+
+		ReadContext: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A search filter for the user search, such as `auth_source_type=AuthSourceLdap`. If not set, all users are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"users": {
+				Description: "A list of objects containing information on the users that match the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin": {
+							Description: "Does the user have administrator privileges?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"auth_source_id": {
+							Description: "The ID of the authentication source of the user.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"auth_source_name": {
+							Description: "The name of the authentication source of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "A description of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"firstname": {
+							Description: "The first name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the user.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"last_login_on": {
+							Description: "Timestamp of when the user last logged in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"lastname": {
+							Description: "The last name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"login": {
+							Description: "The login name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mail": {
+							Description: "The email address of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.UsersListOptions)
+
+	if s, ok := d.GetOk("search"); ok {
+		opt.Search = s.(string)
+	}
+
+	users, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.User, error) {
+		u, _, err := client.Users.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &u.ListResponse, u.Results, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userList := []map[string]interface{}{}
+	for _, x := range users {
+		user := make(map[string]interface{})
+		user["admin"] = x.Admin
+		user["auth_source_id"] = x.AuthSourceID
+		user["auth_source_name"] = x.AuthSourceName
+		user["description"] = x.Description
+		user["firstname"] = x.FirstName
+		user["id"] = x.ID
+		user["last_login_on"] = x.LastLoginOn
+		user["lastname"] = x.LastName
+		user["login"] = x.Login
+		user["mail"] = x.Mail
+		userList = append(userList, user)
+	}
+
+	d.SetId("-")
+	d.Set("users", userList)
+
+	return nil
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/umich-vci/gosatellite"
@@ -57,23 +59,43 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"satellite_auth_source_ldap":      dataSourceAuthSourceLDAP(),
-				"satellite_content_view":          dataSourceContentView(),
-				"satellite_lifecycle_environment": dataSourceLifecycleEnvironment(),
-				"satellite_location":              dataSourceLocation(),
-				"satellite_organization":          dataSourceOrganization(),
-				"satellite_permissions":           dataSourcePermissions(),
-				"satellite_products":              dataSourceProducts(),
+				"satellite_auth_source_ldap":       dataSourceAuthSourceLDAP(),
+				"satellite_auth_source_ldaps":      dataSourceAuthSourceLDAPs(),
+				"satellite_content_view":           dataSourceContentView(),
+				"satellite_content_view_version":   dataSourceContentViewVersion(),
+				"satellite_content_views":          dataSourceContentViews(),
+				"satellite_errata":                 dataSourceErrata(),
+				"satellite_host":                   dataSourceHost(),
+				"satellite_hostgroup":              dataSourceHostgroup(),
+				"satellite_hosts":                  dataSourceHosts(),
+				"satellite_lifecycle_environment":  dataSourceLifecycleEnvironment(),
+				"satellite_lifecycle_environments": dataSourceLifecycleEnvironments(),
+				"satellite_location":               dataSourceLocation(),
+				"satellite_locations":              dataSourceLocations(),
+				"satellite_module_streams":         dataSourceModuleStreams(),
+				"satellite_organization":           dataSourceOrganization(),
+				"satellite_organizations":          dataSourceOrganizations(),
+				"satellite_packages":               dataSourcePackages(),
+				"satellite_permissions":            dataSourcePermissions(),
+				"satellite_products":               dataSourceProducts(),
+				"satellite_roles":                  dataSourceRoles(),
+				"satellite_subscriptions":          dataSourceSubscriptions(),
+				"satellite_users":                  dataSourceUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"satellite_activation_key":        resourceActivationKey(),
+				"satellite_auth_source_ldap":      resourceAuthSourceLDAP(),
 				"satellite_external_user_group":   resourceExternalUserGroup(),
 				"satellite_filter":                resourceFilter(),
+				"satellite_host":                  resourceHost(),
 				"satellite_host_collection":       resourceHostCollection(),
+				"satellite_hostgroup":             resourceHostgroup(),
 				"satellite_location":              resourceLocation(),
 				"satellite_organization":          resourceOrganization(),
 				"satellite_role":                  resourceRole(),
+				"satellite_role_filters":          resourceRoleFilters(),
 				"satellite_subscription_manifest": resourceSubscriptionManifest(),
+				"satellite_user":                  resourceUser(),
 				"satellite_user_group":            resourceUserGroup(),
 			},
 		}
@@ -112,3 +134,165 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		return &apiClient{Client: *client}, nil
 	}
 }
+
+// serverVersion returns the Foreman version reported by the Satellite server, which
+// is used to check whether features of newer Satellite releases are available.
+func serverVersion(client gosatellite.Client) (*version.Version, error) {
+	status, _, err := client.Status.Get(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Version == nil {
+		return nil, fmt.Errorf("the Satellite server did not report its version")
+	}
+
+	return version.NewVersion(*status.Version)
+}
+
+// listPerPage is the number of results requested per page when every page of a list is collected.
+const listPerPage = 100
+
+// listAllPages collects every page of a list. The list function is called with the Page
+// of opt set to each page in turn and returns the pagination details and results of that
+// page. Pages are requested until as many results as the server reported in the subtotal
+// have been collected.
+func listAllPages[T any](opt *gosatellite.ListOptions, list func() (*gosatellite.ListResponse, *[]T, error)) ([]T, error) {
+	if opt.PerPage == 0 {
+		opt.PerPage = listPerPage
+	}
+
+	results := []T{}
+	for page := 1; ; page++ {
+		opt.Page = page
+
+		resp, pageResults, err := list()
+		if err != nil {
+			return nil, err
+		}
+
+		if pageResults == nil || len(*pageResults) == 0 {
+			break
+		}
+
+		results = append(results, *pageResults...)
+
+		// without a subtotal, a short page is the last page
+		if resp.Subtotal == nil {
+			if len(*pageResults) < opt.PerPage {
+				break
+			}
+			continue
+		}
+
+		if len(results) >= *resp.Subtotal {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// taxonomyAssociationSchema returns the schema of an attribute that associates objects
+// with an organization or location. Satellite associates some objects with a taxonomy
+// itself, so the attribute is only managed when it is set.
+func taxonomyAssociationSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description + " If not set, the associations are left as they are in Satellite. An empty list removes every association.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+}
+
+// taxonomyAssociationIDs returns the IDs of an association attribute to send to Satellite
+// when creating or updating an organization or location, or nil if it is not set or is
+// unchanged. An empty list is sent as is to remove every association.
+func taxonomyAssociationIDs(d *schema.ResourceData, key string) *[]int {
+	if d.GetRawConfig().GetAttr(key).IsNull() {
+		return nil
+	}
+
+	if !d.IsNewResource() && !d.HasChange(key) {
+		return nil
+	}
+
+	rawIDs := d.Get(key).(*schema.Set).List()
+	ids := []int{}
+	for x := range rawIDs {
+		ids = append(ids, rawIDs[x].(int))
+	}
+
+	return &ids
+}
+
+// setTaxonomyAssociationIDs reads back an association attribute of an organization or
+// location. Associations that are not managed are left empty so they are not removed.
+func setTaxonomyAssociationIDs(d *schema.ResourceData, key string, refs *[]gosatellite.TaxonomyRef) {
+	if !attributeConfigured(d, key) {
+		d.Set(key, []int{})
+		return
+	}
+
+	d.Set(key, taxonomyRefIDs(refs))
+}
+
+// taxonomyRefIDs returns the IDs of the objects associated with an organization or location.
+func taxonomyRefIDs(refs *[]gosatellite.TaxonomyRef) []int {
+	ids := []int{}
+	if refs != nil {
+		for _, x := range *refs {
+			ids = append(ids, *x.ID)
+		}
+	}
+	return ids
+}
+
+// attributeConfigured reports whether the set attribute key is set in the configuration.
+// The configuration is not available when the state is refreshed, so an attribute that
+// holds values in the state is then treated as configured.
+func attributeConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsNull() {
+		return !rawConfig.GetAttr(key).IsNull()
+	}
+	return d.Get(key).(*schema.Set).Len() > 0
+}
+
+// clearedIDAttributes returns the optional ID attributes in keys that are no longer
+// configured but still hold an ID in the state.
+func clearedIDAttributes(d *schema.ResourceData, keys ...string) []string {
+	cleared := []string{}
+	for _, key := range keys {
+		if !d.GetRawConfig().GetAttr(key).IsNull() {
+			continue
+		}
+		if old, _ := d.GetChange(key); old.(int) != 0 {
+			cleared = append(cleared, key)
+		}
+	}
+	return cleared
+}
+
+// setAttributesNull sets attributes of a Satellite object to null. gosatellite leaves nil
+// fields out of request bodies, so clearing an optional ID needs a request of its own.
+func setAttributesNull(client gosatellite.Client, path string, object string, attributes []string) error {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	values := make(map[string]interface{})
+	for _, x := range attributes {
+		values[x] = nil
+	}
+
+	req, err := client.NewRequest(context.Background(), http.MethodPut, path, map[string]interface{}{object: values})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(context.Background(), req, nil)
+	return err
+}
@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/umich-vci/gosatellite"
 )
 
 // providerFactories are used to instantiate a provider during acceptance testing.
@@ -21,6 +26,223 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestListAllPages(t *testing.T) {
+	cases := []struct {
+		name     string
+		total    int
+		subtotal bool
+		calls    int
+	}{
+		{"no results", 0, true, 1},
+		{"no results without subtotal", 0, false, 1},
+		{"exact multiple of the page size", 2 * listPerPage, true, 2},
+		{"exact multiple of the page size without subtotal", 2 * listPerPage, false, 3},
+		{"short last page", listPerPage + 7, true, 2},
+		{"short last page without subtotal", listPerPage + 7, false, 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opt := new(gosatellite.ListOptions)
+			calls := 0
+
+			results, err := listAllPages(opt, func() (*gosatellite.ListResponse, *[]int, error) {
+				calls++
+
+				page := []int{}
+				for x := (opt.Page - 1) * opt.PerPage; x < c.total && x < opt.Page*opt.PerPage; x++ {
+					page = append(page, x)
+				}
+
+				resp := new(gosatellite.ListResponse)
+				if c.subtotal {
+					subtotal := c.total
+					resp.Subtotal = &subtotal
+				}
+
+				return resp, &page, nil
+			})
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if len(results) != c.total {
+				t.Fatalf("expected %d results, got %d", c.total, len(results))
+			}
+			for x := range results {
+				if results[x] != x {
+					t.Fatalf("expected result %d to be %d, got %d", x, x, results[x])
+				}
+			}
+
+			if calls != c.calls {
+				t.Fatalf("expected %d calls, got %d", c.calls, calls)
+			}
+		})
+	}
+}
+
+type testStatusService struct {
+	status *gosatellite.Status
+	err    error
+}
+
+func (s testStatusService) Get(ctx context.Context) (*gosatellite.Status, *gosatellite.Response, error) {
+	return s.status, nil, s.err
+}
+
+func TestServerVersion(t *testing.T) {
+	foremanVersion := "3.9.1.6"
+
+	cases := []struct {
+		name    string
+		status  testStatusService
+		version string
+		err     bool
+	}{
+		{"version reported", testStatusService{status: &gosatellite.Status{Version: &foremanVersion}}, "3.9.1.6", false},
+		{"no version reported", testStatusService{status: &gosatellite.Status{}}, "", true},
+		{"request failed", testStatusService{err: errors.New("connection refused")}, "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := serverVersion(gosatellite.Client{Status: c.status})
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected an error, got version %s", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if v.String() != c.version {
+				t.Fatalf("expected version %s, got %s", c.version, v)
+			}
+		})
+	}
+}
+
+func TestTaxonomyAssociationIDs(t *testing.T) {
+	cases := []struct {
+		name   string
+		state  map[string]string
+		config string
+		isNew  bool
+		ids    []int
+	}{
+		{
+			name:   "set on create",
+			state:  map[string]string{"domain_ids.#": "2", "domain_ids.0": "3", "domain_ids.1": "5"},
+			config: `{"name": "org", "domain_ids": [3, 5]}`,
+			isNew:  true,
+			ids:    []int{3, 5},
+		},
+		{
+			name:   "empty on create",
+			state:  map[string]string{"domain_ids.#": "0"},
+			config: `{"name": "org", "domain_ids": []}`,
+			isNew:  true,
+			ids:    []int{},
+		},
+		{
+			name:   "not set on create",
+			state:  map[string]string{},
+			config: `{"name": "org"}`,
+			isNew:  true,
+		},
+		{
+			name:   "unchanged on update",
+			state:  map[string]string{"domain_ids.#": "1", "domain_ids.0": "3"},
+			config: `{"name": "org", "domain_ids": [3]}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := testResourceData(t, resourceOrganization(), c.state, c.config)
+			if c.isNew {
+				d.MarkNewResource()
+			}
+
+			ids := taxonomyAssociationIDs(d, "domain_ids")
+			if c.ids == nil {
+				if ids != nil {
+					t.Fatalf("expected no IDs to be sent, got %v", *ids)
+				}
+				return
+			}
+			if ids == nil {
+				t.Fatalf("expected %v to be sent, got nil", c.ids)
+			}
+			if !sameIntSet(*ids, c.ids) {
+				t.Fatalf("expected %v, got %v", c.ids, *ids)
+			}
+		})
+	}
+
+	readCases := []struct {
+		name   string
+		state  map[string]string
+		config string
+		ids    []int
+	}{
+		{
+			name:   "managed",
+			state:  map[string]string{"domain_ids.#": "1", "domain_ids.0": "3"},
+			config: `{"name": "org", "domain_ids": [3]}`,
+			ids:    []int{3, 5},
+		},
+		{
+			name:   "managed and empty",
+			state:  map[string]string{"domain_ids.#": "0"},
+			config: `{"name": "org", "domain_ids": []}`,
+			ids:    []int{3, 5},
+		},
+		{
+			name:   "unmanaged",
+			state:  map[string]string{},
+			config: `{"name": "org"}`,
+			ids:    []int{},
+		},
+	}
+
+	for _, c := range readCases {
+		t.Run("read "+c.name, func(t *testing.T) {
+			d := testResourceData(t, resourceOrganization(), c.state, c.config)
+
+			three, five := 3, 5
+			setTaxonomyAssociationIDs(d, "domain_ids", &[]gosatellite.TaxonomyRef{{ID: &three}, {ID: &five}})
+
+			ids := []int{}
+			for _, x := range d.Get("domain_ids").(*schema.Set).List() {
+				ids = append(ids, x.(int))
+			}
+			if !sameIntSet(ids, c.ids) {
+				t.Fatalf("expected %v, got %v", c.ids, ids)
+			}
+		})
+	}
+}
+
+// testResourceData returns the ResourceData of resource r with the given flatmap state
+// and a raw configuration decoded from JSON.
+func testResourceData(t *testing.T, r *schema.Resource, state map[string]string, config string) *schema.ResourceData {
+	t.Helper()
+
+	rawConfig, err := ctyjson.Unmarshal([]byte(config), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return r.Data(&terraform.InstanceState{
+		ID:         "1",
+		Attributes: state,
+		RawConfig:  rawConfig,
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
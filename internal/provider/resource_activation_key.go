@@ -2,13 +2,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"strconv"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/umich-vci/gosatellite"
 )
 
+// multiCVMinimumVersion is the Foreman version of Satellite 6.15, the first release
+// that accepts content view environments on an activation key.
+var multiCVMinimumVersion = version.Must(version.NewVersion("3.9"))
+
 func resourceActivationKey() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource to manage a Red Hat Satellite Activation Key.",
@@ -18,6 +26,8 @@ func resourceActivationKey() *schema.Resource {
 		UpdateContext: resourceActivationKeyUpdate,
 		DeleteContext: resourceActivationKeyDelete,
 
+		CustomizeDiff: resourceActivationKeyCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -33,19 +43,61 @@ func resourceActivationKey() *schema.Resource {
 				Required:    true,
 			},
 			"content_view_id": {
-				Description: "The ID of the content view to associate with the activation key.",
+				Description:   "The ID of the content view to associate with the activation key. If not set on a key created with `copy_from_id`, the content view of the original key is kept.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"content_view_environments"},
+			},
+			"content_view_environments": {
+				Description:   "An ordered list of content view environment labels, in the form `lifecycle_environment_label/content_view_label`, to associate with the activation key. The first entry has the highest priority. Requires Satellite 6.15 or later. Conflicts with `content_view_id` and `environment_id`.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"content_view_id", "environment_id"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"copy_from_id": {
+				Description: "The ID of an existing activation key to copy when the activation key is created. The copy must be in the same organization as the original key. Attributes set in the configuration are then applied to the copy, and `host_collection_ids`, `subscription` and `content_override` are only managed when they are set. Changing this forces a new resource to be created.",
 				Type:        schema.TypeInt,
 				Optional:    true,
+				ForceNew:    true,
+			},
+			"content_override": {
+				Description: "An override of whether a repository is enabled for hosts registered with the activation key. Overrides set outside of Terraform are removed.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_label": {
+							Description: "The label of the repository content, such as `rhel-8-for-x86_64-baseos-rpms`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"value": {
+							Description:  "Valid values are `enabled`, `disabled` and `default`. A value of `default` removes any override so the default of the repository is used.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled", "default"}, false),
+						},
+					},
+				},
 			},
 			"description": {
-				Description: "A description of the activation key.",
+				Description: "A description of the activation key. If not set on a key created with `copy_from_id`, the description of the original key is kept.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"environment_id": {
-				Description: "The ID of the environment that contains the `content_view_id`.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Description:   "The ID of the environment that contains the `content_view_id`. If not set on a key created with `copy_from_id`, the environment of the original key is kept.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"content_view_environments"},
 			},
 			"host_collection_ids": {
 				Description: "A list of host collection IDs to associate with the activation key. Machines activated with the key will be added to these host collections.",
@@ -66,6 +118,61 @@ func resourceActivationKey() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"auto_attach": {
+				Description: "Should hosts registered with the activation key automatically attach the best matching subscriptions? When set to false, only the subscriptions in `subscription` are attached.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"purpose_addons": {
+				Description: "A list of system purpose add-ons to set on hosts registered with the activation key.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"purpose_role": {
+				Description: "The system purpose role to set on hosts registered with the activation key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"purpose_usage": {
+				Description: "The system purpose usage to set on hosts registered with the activation key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"release_version": {
+				Description: "The release version, such as `8.6`, that hosts registered with the activation key are locked to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"service_level": {
+				Description: "The service level, such as `Premium`, `Standard` or `Self-Support`, to set on hosts registered with the activation key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"subscription": {
+				Description: "A subscription to attach to the activation key.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the subscription.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"quantity": {
+							Description:  "The number of entitlements of the subscription to attach.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -92,21 +199,82 @@ func resourceActivationKeyRead(ctx context.Context, d *schema.ResourceData, meta
 	// set values we can directly set from struct
 	d.Set("organization_id", activationKey.OrganizationID)
 	d.Set("name", activationKey.Name)
-	d.Set("content_view_id", activationKey.ContentViewID)
 	d.Set("description", activationKey.Description)
-	d.Set("environment_id", activationKey.EnvironmentID)
 	d.Set("max_hosts", activationKey.MaxHosts)
 	d.Set("unlimited_hosts", activationKey.UnlimitedHosts)
+	d.Set("auto_attach", activationKey.AutoAttach)
+	d.Set("purpose_addons", activationKey.PurposeAddons)
+	d.Set("purpose_role", activationKey.PurposeRole)
+	d.Set("purpose_usage", activationKey.PurposeUsage)
+	d.Set("release_version", activationKey.ReleaseVersion)
+	d.Set("service_level", activationKey.ServiceLevel)
+
+	// only report the list of content view environments when it is used, so keys with a
+	// single content view and environment keep using content_view_id and environment_id
+	cveLabels := activationKeyContentViewEnvironments(activationKey)
+	if len(d.Get("content_view_environments").([]interface{})) > 0 || len(cveLabels) > 1 {
+		d.Set("content_view_environments", cveLabels)
+		d.Set("content_view_id", nil)
+		d.Set("environment_id", nil)
+	} else {
+		d.Set("content_view_environments", nil)
+		d.Set("content_view_id", activationKey.ContentViewID)
+		d.Set("environment_id", activationKey.EnvironmentID)
+	}
 
-	var hcIDs []int
-	for _, x := range *activationKey.HostCollections {
-		hcIDs = append(hcIDs, *x.ID)
+	if activationKeyManages(d, "host_collection_ids") {
+		var hcIDs []int
+		for _, x := range *activationKey.HostCollections {
+			hcIDs = append(hcIDs, *x.ID)
+		}
+		d.Set("host_collection_ids", hcIDs)
+	}
+
+	if activationKeyManages(d, "content_override") {
+		activationKeySetContentOverrides(d, activationKey)
+	}
+
+	if activationKeyManages(d, "subscription") {
+		subscriptions, err := activationKeySubscriptions(client, akID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		subscriptionList := []map[string]interface{}{}
+		for _, x := range subscriptions {
+			subscription := make(map[string]interface{})
+			subscription["id"] = x.ID
+			subscription["quantity"] = x.Quantity
+			subscriptionList = append(subscriptionList, subscription)
+		}
+		d.Set("subscription", subscriptionList)
 	}
-	d.Set("host_collection_ids", hcIDs)
 
 	return nil
 }
 
+func activationKeySetContentOverrides(d *schema.ResourceData, activationKey *gosatellite.ActivationKey) {
+	contentOverrides := activationKeyContentOverrides(activationKey)
+	overrideList := []map[string]interface{}{}
+	for label, value := range contentOverrides {
+		override := make(map[string]interface{})
+		override["content_label"] = label
+		override["value"] = value
+		overrideList = append(overrideList, override)
+	}
+	// keep overrides set to default that have no override on the server so they don't show as a change
+	for _, x := range d.Get("content_override").(*schema.Set).List() {
+		override := x.(map[string]interface{})
+		if override["value"].(string) != "default" {
+			continue
+		}
+		if _, ok := contentOverrides[override["content_label"].(string)]; !ok {
+			overrideList = append(overrideList, override)
+		}
+	}
+	d.Set("content_override", overrideList)
+}
+
 func resourceActivationKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*apiClient).Client
 
@@ -114,11 +282,38 @@ func resourceActivationKeyCreate(ctx context.Context, d *schema.ResourceData, me
 	name := d.Get("name").(string)
 	unlimited := d.Get("unlimited_hosts").(bool)
 
+	if src, ok := d.GetOk("copy_from_id"); ok {
+		source, _, err := client.ActivationKeys.Get(context.Background(), src.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if source.OrganizationID == nil || *source.OrganizationID != orgID {
+			return diag.Errorf("copy_from_id %d is not an activation key of organization %d", src.(int), orgID)
+		}
+
+		activationKey, _, err := client.ActivationKeys.Copy(context.Background(), src.(int), name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.Itoa(*activationKey.ID))
+
+		err = activationKeyApplyCopy(client, d, *activationKey.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceActivationKeyRead(ctx, d, meta)
+	}
+
 	createBody := new(gosatellite.ActivationKeyCreate)
 	createBody.OrganizationID = &orgID
 	createBody.Name = &name
 	createBody.UnlimitedHosts = &unlimited
 
+	autoAttach := d.Get("auto_attach").(bool)
+	createBody.AutoAttach = &autoAttach
+
 	if c, ok := d.GetOk("content_view_id"); ok {
 		cvID := c.(int)
 		createBody.ContentViewID = &cvID
@@ -134,11 +329,45 @@ func resourceActivationKeyCreate(ctx context.Context, d *schema.ResourceData, me
 		createBody.EnvironmentID = &eID
 	}
 
+	if cve, ok := d.GetOk("content_view_environments"); ok {
+		cveLabels := activationKeyContentViewEnvironmentLabels(cve.([]interface{}))
+		createBody.ContentViewEnvironments = &cveLabels
+	}
+
 	if m, ok := d.GetOk("max_hosts"); ok {
 		max := m.(int)
 		createBody.MaxHosts = &max
 	}
 
+	if pa, ok := d.GetOk("purpose_addons"); ok {
+		rawPurposeAddons := pa.(*schema.Set).List()
+		purposeAddons := []string{}
+		for x := range rawPurposeAddons {
+			purposeAddons = append(purposeAddons, rawPurposeAddons[x].(string))
+		}
+		createBody.PurposeAddons = &purposeAddons
+	}
+
+	if pr, ok := d.GetOk("purpose_role"); ok {
+		purposeRole := pr.(string)
+		createBody.PurposeRole = &purposeRole
+	}
+
+	if pu, ok := d.GetOk("purpose_usage"); ok {
+		purposeUsage := pu.(string)
+		createBody.PurposeUsage = &purposeUsage
+	}
+
+	if rv, ok := d.GetOk("release_version"); ok {
+		releaseVersion := rv.(string)
+		createBody.ReleaseVersion = &releaseVersion
+	}
+
+	if sl, ok := d.GetOk("service_level"); ok {
+		serviceLevel := sl.(string)
+		createBody.ServiceLevel = &serviceLevel
+	}
+
 	activationKey, _, err := client.ActivationKeys.Create(context.Background(), *createBody)
 	if err != nil {
 		return diag.FromErr(err)
@@ -158,6 +387,28 @@ func resourceActivationKeyCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if sub, ok := d.GetOk("subscription"); ok {
+		subAddList := []gosatellite.ActivationKeySubscriptionAdd{}
+		for _, x := range sub.(*schema.Set).List() {
+			subscription := x.(map[string]interface{})
+			subAddList = append(subAddList, gosatellite.ActivationKeySubscriptionAdd{
+				ID:       subscription["id"].(int),
+				Quantity: subscription["quantity"].(int),
+			})
+		}
+		_, _, err := client.ActivationKeys.AddSubscriptions(context.Background(), *activationKey.ID, subAddList)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if co, ok := d.GetOk("content_override"); ok {
+		err := activationKeyReconcileContentOverrides(client, *activationKey.ID, co.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceActivationKeyRead(ctx, d, meta)
 }
 
@@ -197,6 +448,13 @@ func resourceActivationKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 		updateBody.EnvironmentID = &eID
 		update = true
 	}
+	if d.HasChange("content_view_environments") {
+		if cve, ok := d.GetOk("content_view_environments"); ok {
+			cveLabels := activationKeyContentViewEnvironmentLabels(cve.([]interface{}))
+			updateBody.ContentViewEnvironments = &cveLabels
+			update = true
+		}
+	}
 	if d.HasChange("max_hosts") {
 		maxHosts := d.Get("max_hosts").(int)
 		updateBody.MaxHosts = &maxHosts
@@ -207,6 +465,40 @@ func resourceActivationKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 		updateBody.UnlimitedHosts = &unlimited
 		update = true
 	}
+	if d.HasChange("auto_attach") {
+		autoAttach := d.Get("auto_attach").(bool)
+		updateBody.AutoAttach = &autoAttach
+		update = true
+	}
+	if d.HasChange("purpose_addons") {
+		rawPurposeAddons := d.Get("purpose_addons").(*schema.Set).List()
+		purposeAddons := []string{}
+		for x := range rawPurposeAddons {
+			purposeAddons = append(purposeAddons, rawPurposeAddons[x].(string))
+		}
+		updateBody.PurposeAddons = &purposeAddons
+		update = true
+	}
+	if d.HasChange("purpose_role") {
+		purposeRole := d.Get("purpose_role").(string)
+		updateBody.PurposeRole = &purposeRole
+		update = true
+	}
+	if d.HasChange("purpose_usage") {
+		purposeUsage := d.Get("purpose_usage").(string)
+		updateBody.PurposeUsage = &purposeUsage
+		update = true
+	}
+	if d.HasChange("release_version") {
+		releaseVersion := d.Get("release_version").(string)
+		updateBody.ReleaseVersion = &releaseVersion
+		update = true
+	}
+	if d.HasChange("service_level") {
+		serviceLevel := d.Get("service_level").(string)
+		updateBody.ServiceLevel = &serviceLevel
+		update = true
+	}
 
 	if update {
 		_, _, err = client.ActivationKeys.Update(context.Background(), akID, *updateBody)
@@ -215,7 +507,7 @@ func resourceActivationKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange("host_collection_ids") {
+	if d.HasChange("host_collection_ids") && activationKeyManages(d, "host_collection_ids") {
 		oldHC, newHC := d.GetChange("host_collection_ids")
 		rawOldHC := oldHC.(*schema.Set).List()
 		rawNewHC := newHC.(*schema.Set).List()
@@ -262,6 +554,21 @@ func resourceActivationKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	}
 
+	if d.HasChange("subscription") && activationKeyManages(d, "subscription") {
+		oldSub, newSub := d.GetChange("subscription")
+		err := activationKeyReconcileSubscriptions(client, akID, oldSub.(*schema.Set).List(), newSub.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("content_override") && activationKeyManages(d, "content_override") {
+		err := activationKeyReconcileContentOverrides(client, akID, d.Get("content_override").(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceActivationKeyRead(ctx, d, meta)
 }
 
@@ -282,3 +589,330 @@ func resourceActivationKeyDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// activationKeyContentOverrides returns the repository enablement overrides of an
+// activation key as a map of content label to `enabled` or `disabled`.
+func activationKeyContentOverrides(activationKey *gosatellite.ActivationKey) map[string]string {
+	overrides := make(map[string]string)
+	if activationKey.ContentOverrides == nil {
+		return overrides
+	}
+
+	for _, x := range *activationKey.ContentOverrides {
+		if x.ContentLabel == nil || x.Value == nil {
+			continue
+		}
+		if x.Name != nil && *x.Name != "enabled" {
+			continue
+		}
+		switch *x.Value {
+		case "1", "true":
+			overrides[*x.ContentLabel] = "enabled"
+		case "0", "false":
+			overrides[*x.ContentLabel] = "disabled"
+		}
+	}
+
+	return overrides
+}
+
+// activationKeyReconcileContentOverrides compares the desired content overrides with the
+// overrides currently set on the server and changes only the ones that differ.
+func activationKeyReconcileContentOverrides(client gosatellite.Client, akID int, desired []interface{}) error {
+	activationKey, _, err := client.ActivationKeys.Get(context.Background(), akID)
+	if err != nil {
+		return err
+	}
+
+	current := activationKeyContentOverrides(activationKey)
+	wanted := make(map[string]bool)
+	overrides := []gosatellite.ActivationKeyContentOverride{}
+
+	for x := range desired {
+		override := desired[x].(map[string]interface{})
+		label := override["content_label"].(string)
+		value := override["value"].(string)
+		wanted[label] = true
+
+		currentValue, ok := current[label]
+		if value == "default" {
+			if ok {
+				overrides = append(overrides, gosatellite.ActivationKeyContentOverride{ContentLabel: label, Remove: true})
+			}
+			continue
+		}
+
+		if currentValue != value {
+			overrides = append(overrides, gosatellite.ActivationKeyContentOverride{
+				ContentLabel: label,
+				Value:        strconv.FormatBool(value == "enabled"),
+			})
+		}
+	}
+
+	for label := range current {
+		if !wanted[label] {
+			overrides = append(overrides, gosatellite.ActivationKeyContentOverride{ContentLabel: label, Remove: true})
+		}
+	}
+
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	_, _, err = client.ActivationKeys.ContentOverride(context.Background(), akID, overrides)
+
+	return err
+}
+
+// activationKeyReconcileSubscriptions adds the subscriptions in newSubs that are not in
+// oldSubs or have a different quantity, and then removes the subscriptions in oldSubs
+// that are not in newSubs.
+func activationKeyReconcileSubscriptions(client gosatellite.Client, akID int, oldSubs []interface{}, newSubs []interface{}) error {
+	oldQuantities := make(map[int]int)
+	for x := range oldSubs {
+		subscription := oldSubs[x].(map[string]interface{})
+		oldQuantities[subscription["id"].(int)] = subscription["quantity"].(int)
+	}
+
+	newQuantities := make(map[int]int)
+	for x := range newSubs {
+		subscription := newSubs[x].(map[string]interface{})
+		newQuantities[subscription["id"].(int)] = subscription["quantity"].(int)
+	}
+
+	// adding a subscription that is already attached sets its quantity in place
+	subAddList := []gosatellite.ActivationKeySubscriptionAdd{}
+	for id, quantity := range newQuantities {
+		if oldQuantity, ok := oldQuantities[id]; !ok || oldQuantity != quantity {
+			subAddList = append(subAddList, gosatellite.ActivationKeySubscriptionAdd{
+				ID:       id,
+				Quantity: quantity,
+			})
+		}
+	}
+
+	subRemoveList := []int{}
+	for id := range oldQuantities {
+		if _, ok := newQuantities[id]; !ok {
+			subRemoveList = append(subRemoveList, id)
+		}
+	}
+
+	// subscriptions are added first so a failure never leaves the key without them
+	if len(subAddList) > 0 {
+		_, _, err := client.ActivationKeys.AddSubscriptions(context.Background(), akID, subAddList)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(subRemoveList) > 0 {
+		_, _, err := client.ActivationKeys.RemoveSubscriptions(context.Background(), akID, subRemoveList)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// activationKeySubscriptions returns every subscription attached to an activation key.
+func activationKeySubscriptions(client gosatellite.Client, akID int) ([]gosatellite.ActivationKeySubscription, error) {
+	opt := new(gosatellite.ActivationKeySubscriptionsListOptions)
+
+	return listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.ActivationKeySubscription, error) {
+		s, _, err := client.ActivationKeys.ListSubscriptions(context.Background(), akID, *opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &s.ListResponse, s.Results, nil
+	})
+}
+
+// activationKeyApplyCopy applies the configuration to an activation key that was just
+// copied from another key. Host collections, subscriptions and content overrides are
+// reconciled against what the copy inherited from the original key.
+func activationKeyApplyCopy(client gosatellite.Client, d *schema.ResourceData, akID int) error {
+	updateBody := new(gosatellite.ActivationKeyUpdate)
+
+	unlimited := d.Get("unlimited_hosts").(bool)
+	updateBody.UnlimitedHosts = &unlimited
+
+	autoAttach := d.Get("auto_attach").(bool)
+	updateBody.AutoAttach = &autoAttach
+
+	if c, ok := d.GetOk("content_view_id"); ok {
+		cvID := c.(int)
+		updateBody.ContentViewID = &cvID
+	}
+
+	if e, ok := d.GetOk("environment_id"); ok {
+		eID := e.(int)
+		updateBody.EnvironmentID = &eID
+	}
+
+	if cve, ok := d.GetOk("content_view_environments"); ok {
+		cveLabels := activationKeyContentViewEnvironmentLabels(cve.([]interface{}))
+		updateBody.ContentViewEnvironments = &cveLabels
+	}
+
+	if desc, ok := d.GetOk("description"); ok {
+		description := desc.(string)
+		updateBody.Description = &description
+	}
+
+	if m, ok := d.GetOk("max_hosts"); ok {
+		maxHosts := m.(int)
+		updateBody.MaxHosts = &maxHosts
+	}
+
+	if pa, ok := d.GetOk("purpose_addons"); ok {
+		rawPurposeAddons := pa.(*schema.Set).List()
+		purposeAddons := []string{}
+		for x := range rawPurposeAddons {
+			purposeAddons = append(purposeAddons, rawPurposeAddons[x].(string))
+		}
+		updateBody.PurposeAddons = &purposeAddons
+	}
+
+	if pr, ok := d.GetOk("purpose_role"); ok {
+		purposeRole := pr.(string)
+		updateBody.PurposeRole = &purposeRole
+	}
+
+	if pu, ok := d.GetOk("purpose_usage"); ok {
+		purposeUsage := pu.(string)
+		updateBody.PurposeUsage = &purposeUsage
+	}
+
+	if rv, ok := d.GetOk("release_version"); ok {
+		releaseVersion := rv.(string)
+		updateBody.ReleaseVersion = &releaseVersion
+	}
+
+	if sl, ok := d.GetOk("service_level"); ok {
+		serviceLevel := sl.(string)
+		updateBody.ServiceLevel = &serviceLevel
+	}
+
+	activationKey, _, err := client.ActivationKeys.Update(context.Background(), akID, *updateBody)
+	if err != nil {
+		return err
+	}
+
+	// host collections, subscriptions and content overrides that are not configured
+	// are kept as they were copied from the original key
+	if activationKeyManages(d, "host_collection_ids") {
+		currentHCIDs := []int{}
+		for _, x := range *activationKey.HostCollections {
+			currentHCIDs = append(currentHCIDs, *x.ID)
+		}
+
+		rawHCIDs := d.Get("host_collection_ids").(*schema.Set).List()
+		hcIDs := []int{}
+		for x := range rawHCIDs {
+			hcIDs = append(hcIDs, rawHCIDs[x].(int))
+		}
+
+		hcAddList, hcRemoveList := intListChanges(currentHCIDs, hcIDs)
+
+		if len(hcAddList) > 0 {
+			_, _, err := client.ActivationKeys.AssociateHostCollections(context.Background(), akID, hcAddList)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(hcRemoveList) > 0 {
+			_, _, err := client.ActivationKeys.DisassociateHostCollections(context.Background(), akID, hcRemoveList)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if activationKeyManages(d, "subscription") {
+		subscriptions, err := activationKeySubscriptions(client, akID)
+		if err != nil {
+			return err
+		}
+
+		currentSubs := []interface{}{}
+		for _, x := range subscriptions {
+			subscription := make(map[string]interface{})
+			subscription["id"] = *x.ID
+			subscription["quantity"] = *x.Quantity
+			currentSubs = append(currentSubs, subscription)
+		}
+
+		err = activationKeyReconcileSubscriptions(client, akID, currentSubs, d.Get("subscription").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	if activationKeyManages(d, "content_override") {
+		return activationKeyReconcileContentOverrides(client, akID, d.Get("content_override").(*schema.Set).List())
+	}
+
+	return nil
+}
+
+// activationKeyManages reports whether the set attribute key is managed. Keys created
+// with copy_from_id keep what was copied from the original key unless it is configured.
+func activationKeyManages(d *schema.ResourceData, key string) bool {
+	return d.Get("copy_from_id").(int) == 0 || attributeConfigured(d, key)
+}
+
+// activationKeyContentViewEnvironments returns the labels of the content view
+// environments of an activation key in priority order.
+func activationKeyContentViewEnvironments(activationKey *gosatellite.ActivationKey) []string {
+	labels := []string{}
+	if activationKey.ContentViewEnvironments == nil {
+		return labels
+	}
+
+	for _, x := range *activationKey.ContentViewEnvironments {
+		if x.Label != nil {
+			labels = append(labels, *x.Label)
+		}
+	}
+
+	return labels
+}
+
+// activationKeyContentViewEnvironmentLabels converts the configured list of content
+// view environments to a list of labels, keeping their order.
+func activationKeyContentViewEnvironmentLabels(rawLabels []interface{}) []string {
+	labels := []string{}
+	for x := range rawLabels {
+		labels = append(labels, rawLabels[x].(string))
+	}
+
+	return labels
+}
+
+func resourceActivationKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("content_view_environments") || !d.NewValueKnown("content_view_environments") {
+		return nil
+	}
+
+	if len(d.Get("content_view_environments").([]interface{})) == 0 || meta == nil {
+		return nil
+	}
+
+	client := meta.(*apiClient).Client
+
+	v, err := serverVersion(client)
+	if err != nil {
+		log.Printf("[WARN] unable to determine the Satellite server version, skipping validation of content_view_environments: %s", err)
+		return nil
+	}
+
+	if v.LessThan(multiCVMinimumVersion) {
+		return fmt.Errorf("content_view_environments requires Satellite 6.15 or later, but the server is running Foreman %s", v)
+	}
+
+	return nil
+}
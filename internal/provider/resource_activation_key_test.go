@@ -2,6 +2,7 @@ package provider
 
 import (
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,3 +31,35 @@ resource "scaffolding_resource" "foo" {
   sample_attribute = "bar"
 }
 `
+
+func TestActivationKeyManages(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  string
+		managed []string
+	}{
+		{"created", `{"name": "key", "organization_id": 1}`, []string{"host_collection_ids", "subscription", "content_override"}},
+		{"copied", `{"name": "key", "organization_id": 1, "copy_from_id": 2}`, []string{}},
+		{"copied with host collections", `{"name": "key", "organization_id": 1, "copy_from_id": 2, "host_collection_ids": []}`, []string{"host_collection_ids"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := map[string]string{"name": "key", "organization_id": "1"}
+			if strings.Contains(c.config, "copy_from_id") {
+				state["copy_from_id"] = "2"
+			}
+			d := testResourceData(t, resourceActivationKey(), state, c.config)
+
+			managed := []string{}
+			for _, key := range []string{"host_collection_ids", "subscription", "content_override"} {
+				if activationKeyManages(d, key) {
+					managed = append(managed, key)
+				}
+			}
+			if strings.Join(managed, ",") != strings.Join(c.managed, ",") {
+				t.Fatalf("expected %v to be managed, got %v", c.managed, managed)
+			}
+		})
+	}
+}
@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+func resourceAuthSourceLDAP() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource to manage an LDAP Authentication Source in Red Hat Satellite.",
+
+		CreateContext: resourceAuthSourceLDAPCreate,
+		ReadContext:   resourceAuthSourceLDAPRead,
+		UpdateContext: resourceAuthSourceLDAPUpdate,
+		DeleteContext: resourceAuthSourceLDAPDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the LDAP authentication source.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"host": {
+				Description:  "The hostname of the LDAP server.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"server_type": {
+				Description:  "The type of the LDAP server. Valid values are `free_ipa`, `active_directory` and `posix`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"free_ipa", "active_directory", "posix"}, false),
+			},
+			"account": {
+				Description: "The DN of the LDAP Bind Account.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"account_password": {
+				Description: "The password of the LDAP Bind Account. This is a write-only argument that requires Terraform 1.11 or later. It is never stored in the state, so it is only sent when the authentication source is created or `account_password_version` changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"account_password_version": {
+				Description: "An arbitrary number that is changed to send `account_password` to Satellite again, rotating the password of the LDAP Bind Account.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"attr_firstname": {
+				Description: "The LDAP attribute that maps to first name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attr_lastname": {
+				Description: "The LDAP attribute that maps to last name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attr_login": {
+				Description: "The LDAP attribute that maps to username.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attr_mail": {
+				Description: "The LDAP attribute that maps to email address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"attr_photo": {
+				Description: "The LDAP attribute that maps to a photo.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"base_dn": {
+				Description: "The base DN from which LDAP searches will be performed.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"groups_base": {
+				Description: "The base DN from which LDAP searches for groups will be performed.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ldap_filter": {
+				Description: "An LDAP filter used to restrict the users that can authenticate.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location_ids": {
+				Description: "A list of IDs of locations to associate with the LDAP authentication source.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"onthefly_register": {
+				Description: "Should users be created in Satellite automatically the first time they log in?",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"organization_ids": {
+				Description: "A list of IDs of organizations to associate with the LDAP authentication source.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"port": {
+				Description:  "The port the LDAP server is listening on.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      389,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"tls": {
+				Description: "Should TLS be used to connect to the LDAP server?",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"use_netgroups": {
+				Description: "Should NIS netgroups be used instead of posix groups? Only applies to a `server_type` of `posix` or `free_ipa`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"usergroup_sync": {
+				Description: "Should external user groups be synced automatically when users log in?",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"created_at": {
+				Description: "Timestamp of when the LDAP authentication source was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of the authentication source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "Timestamp of when the LDAP authentication source was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAuthSourceLDAPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	asID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authSource, resp, err := client.AuthSourceLDAPs.Get(context.Background(), asID)
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	locationIDs := []int{}
+	for _, x := range *authSource.Locations {
+		locationIDs = append(locationIDs, *x.ID)
+	}
+
+	organizationIDs := []int{}
+	for _, x := range *authSource.Organizations {
+		organizationIDs = append(organizationIDs, *x.ID)
+	}
+
+	d.Set("account", authSource.Account)
+	d.Set("attr_firstname", authSource.AttrFirstName)
+	d.Set("attr_lastname", authSource.AttrLastName)
+	d.Set("attr_login", authSource.AttrLogin)
+	d.Set("attr_mail", authSource.AttrMail)
+	d.Set("attr_photo", authSource.AttrPhoto)
+	d.Set("base_dn", authSource.BaseDN)
+	d.Set("created_at", authSource.CreatedAt)
+	d.Set("groups_base", authSource.GroupsBase)
+	d.Set("host", authSource.Host)
+	d.Set("ldap_filter", authSource.LDAPFilter)
+	d.Set("location_ids", locationIDs)
+	d.Set("name", authSource.Name)
+	d.Set("onthefly_register", authSource.OnTheFlyRegister)
+	d.Set("organization_ids", organizationIDs)
+	d.Set("port", authSource.Port)
+	d.Set("server_type", authSource.ServerType)
+	d.Set("tls", authSource.TLS)
+	d.Set("type", authSource.Type)
+	d.Set("use_netgroups", authSource.UseNetGroups)
+	d.Set("updated_at", authSource.UpdatedAt)
+	d.Set("usergroup_sync", authSource.UserGroupSync)
+
+	return nil
+}
+
+func resourceAuthSourceLDAPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	name := d.Get("name").(string)
+	host := d.Get("host").(string)
+	serverType := d.Get("server_type").(string)
+	port := d.Get("port").(int)
+
+	createBody := new(gosatellite.AuthSourceLDAPCreate)
+	createBody.AuthSourceLDAP.Name = &name
+	createBody.AuthSourceLDAP.Host = &host
+	createBody.AuthSourceLDAP.ServerType = &serverType
+	createBody.AuthSourceLDAP.Port = &port
+
+	if a, ok := d.GetOk("account"); ok {
+		account := a.(string)
+		createBody.AuthSourceLDAP.Account = &account
+	}
+
+	if accountPassword, ok := authSourceLDAPAccountPassword(d); ok {
+		createBody.AuthSourceLDAP.AccountPassword = &accountPassword
+	}
+
+	if af, ok := d.GetOk("attr_firstname"); ok {
+		attrFirstName := af.(string)
+		createBody.AuthSourceLDAP.AttrFirstName = &attrFirstName
+	}
+
+	if al, ok := d.GetOk("attr_lastname"); ok {
+		attrLastName := al.(string)
+		createBody.AuthSourceLDAP.AttrLastName = &attrLastName
+	}
+
+	if al, ok := d.GetOk("attr_login"); ok {
+		attrLogin := al.(string)
+		createBody.AuthSourceLDAP.AttrLogin = &attrLogin
+	}
+
+	if am, ok := d.GetOk("attr_mail"); ok {
+		attrMail := am.(string)
+		createBody.AuthSourceLDAP.AttrMail = &attrMail
+	}
+
+	if ap, ok := d.GetOk("attr_photo"); ok {
+		attrPhoto := ap.(string)
+		createBody.AuthSourceLDAP.AttrPhoto = &attrPhoto
+	}
+
+	if bd, ok := d.GetOk("base_dn"); ok {
+		baseDN := bd.(string)
+		createBody.AuthSourceLDAP.BaseDN = &baseDN
+	}
+
+	if gb, ok := d.GetOk("groups_base"); ok {
+		groupsBase := gb.(string)
+		createBody.AuthSourceLDAP.GroupsBase = &groupsBase
+	}
+
+	if lf, ok := d.GetOk("ldap_filter"); ok {
+		ldapFilter := lf.(string)
+		createBody.AuthSourceLDAP.LDAPFilter = &ldapFilter
+	}
+
+	if o, ok := d.GetOk("onthefly_register"); ok {
+		onTheFlyRegister := o.(bool)
+		createBody.AuthSourceLDAP.OnTheFlyRegister = &onTheFlyRegister
+	}
+
+	if t, ok := d.GetOk("tls"); ok {
+		tls := t.(bool)
+		createBody.AuthSourceLDAP.TLS = &tls
+	}
+
+	if un, ok := d.GetOk("use_netgroups"); ok {
+		useNetGroups := un.(bool)
+		createBody.AuthSourceLDAP.UseNetGroups = &useNetGroups
+	}
+
+	if us, ok := d.GetOk("usergroup_sync"); ok {
+		userGroupSync := us.(bool)
+		createBody.AuthSourceLDAP.UserGroupSync = &userGroupSync
+	}
+
+	if loc, ok := d.GetOk("location_ids"); ok {
+		rawLocationIDs := loc.(*schema.Set).List()
+		locationIDs := []int{}
+		for x := range rawLocationIDs {
+			locationIDs = append(locationIDs, rawLocationIDs[x].(int))
+		}
+		createBody.AuthSourceLDAP.LocationIDs = &locationIDs
+	}
+
+	if org, ok := d.GetOk("organization_ids"); ok {
+		rawOrganizationIDs := org.(*schema.Set).List()
+		organizationIDs := []int{}
+		for x := range rawOrganizationIDs {
+			organizationIDs = append(organizationIDs, rawOrganizationIDs[x].(int))
+		}
+		createBody.AuthSourceLDAP.OrganizationIDs = &organizationIDs
+	}
+
+	authSource, _, err := client.AuthSourceLDAPs.Create(context.Background(), *createBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*authSource.ID))
+
+	return resourceAuthSourceLDAPRead(ctx, d, meta)
+}
+
+func resourceAuthSourceLDAPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	asID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateBody := new(gosatellite.AuthSourceLDAPUpdate)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateBody.AuthSourceLDAP.Name = &name
+	}
+	if d.HasChange("host") {
+		host := d.Get("host").(string)
+		updateBody.AuthSourceLDAP.Host = &host
+	}
+	if d.HasChange("server_type") {
+		serverType := d.Get("server_type").(string)
+		updateBody.AuthSourceLDAP.ServerType = &serverType
+	}
+	if d.HasChange("port") {
+		port := d.Get("port").(int)
+		updateBody.AuthSourceLDAP.Port = &port
+	}
+	if d.HasChange("account") {
+		account := d.Get("account").(string)
+		updateBody.AuthSourceLDAP.Account = &account
+	}
+	if d.HasChange("account_password_version") {
+		if accountPassword, ok := authSourceLDAPAccountPassword(d); ok {
+			updateBody.AuthSourceLDAP.AccountPassword = &accountPassword
+		}
+	}
+	if d.HasChange("attr_firstname") {
+		attrFirstName := d.Get("attr_firstname").(string)
+		updateBody.AuthSourceLDAP.AttrFirstName = &attrFirstName
+	}
+	if d.HasChange("attr_lastname") {
+		attrLastName := d.Get("attr_lastname").(string)
+		updateBody.AuthSourceLDAP.AttrLastName = &attrLastName
+	}
+	if d.HasChange("attr_login") {
+		attrLogin := d.Get("attr_login").(string)
+		updateBody.AuthSourceLDAP.AttrLogin = &attrLogin
+	}
+	if d.HasChange("attr_mail") {
+		attrMail := d.Get("attr_mail").(string)
+		updateBody.AuthSourceLDAP.AttrMail = &attrMail
+	}
+	if d.HasChange("attr_photo") {
+		attrPhoto := d.Get("attr_photo").(string)
+		updateBody.AuthSourceLDAP.AttrPhoto = &attrPhoto
+	}
+	if d.HasChange("base_dn") {
+		baseDN := d.Get("base_dn").(string)
+		updateBody.AuthSourceLDAP.BaseDN = &baseDN
+	}
+	if d.HasChange("groups_base") {
+		groupsBase := d.Get("groups_base").(string)
+		updateBody.AuthSourceLDAP.GroupsBase = &groupsBase
+	}
+	if d.HasChange("ldap_filter") {
+		ldapFilter := d.Get("ldap_filter").(string)
+		updateBody.AuthSourceLDAP.LDAPFilter = &ldapFilter
+	}
+	if d.HasChange("onthefly_register") {
+		onTheFlyRegister := d.Get("onthefly_register").(bool)
+		updateBody.AuthSourceLDAP.OnTheFlyRegister = &onTheFlyRegister
+	}
+	if d.HasChange("tls") {
+		tls := d.Get("tls").(bool)
+		updateBody.AuthSourceLDAP.TLS = &tls
+	}
+	if d.HasChange("use_netgroups") {
+		useNetGroups := d.Get("use_netgroups").(bool)
+		updateBody.AuthSourceLDAP.UseNetGroups = &useNetGroups
+	}
+	if d.HasChange("usergroup_sync") {
+		userGroupSync := d.Get("usergroup_sync").(bool)
+		updateBody.AuthSourceLDAP.UserGroupSync = &userGroupSync
+	}
+	if d.HasChange("location_ids") {
+		rawLocationIDs := d.Get("location_ids").(*schema.Set).List()
+		locationIDs := []int{}
+		for x := range rawLocationIDs {
+			locationIDs = append(locationIDs, rawLocationIDs[x].(int))
+		}
+		updateBody.AuthSourceLDAP.LocationIDs = &locationIDs
+	}
+	if d.HasChange("organization_ids") {
+		rawOrganizationIDs := d.Get("organization_ids").(*schema.Set).List()
+		organizationIDs := []int{}
+		for x := range rawOrganizationIDs {
+			organizationIDs = append(organizationIDs, rawOrganizationIDs[x].(int))
+		}
+		updateBody.AuthSourceLDAP.OrganizationIDs = &organizationIDs
+	}
+
+	_, _, err = client.AuthSourceLDAPs.Update(context.Background(), asID, *updateBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAuthSourceLDAPRead(ctx, d, meta)
+}
+
+func resourceAuthSourceLDAPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	asID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.AuthSourceLDAPs.Delete(context.Background(), asID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// authSourceLDAPAccountPassword returns the write-only account password from the configuration.
+func authSourceLDAPAccountPassword(d *schema.ResourceData) (string, bool) {
+	accountPassword := d.GetRawConfig().GetAttr("account_password")
+	if accountPassword.IsNull() || !accountPassword.IsKnown() {
+		return "", false
+	}
+
+	return accountPassword.AsString(), true
+}
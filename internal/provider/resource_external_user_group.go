@@ -19,6 +19,8 @@ func resourceExternalUserGroup() *schema.Resource {
 		UpdateContext: resourceExternalUserGroupUpdate,
 		DeleteContext: resourceExternalUserGroupDelete,
 
+		CustomizeDiff: resourceExternalUserGroupCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -41,6 +43,19 @@ func resourceExternalUserGroup() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"refresh_on_apply": {
+				Description: "When set to true, the external user group is refreshed from the authentication source on every apply instead of only when it is created or changed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"synced_user_logins": {
+				Description: "A list of the logins of the users in the user group after the external user group was last refreshed.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"auth_source_ldap": {
 				Description: "A list of objects containing the authentication source the associated with the external user group.",
 				Type:        schema.TypeMap,
@@ -85,6 +100,17 @@ func resourceExternalUserGroupRead(ctx context.Context, d *schema.ResourceData,
 	}
 	d.Set("auth_source_ldap", authSourceLDAP)
 
+	ug, _, err := client.UserGroups.Get(context.Background(), ugID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userLogins := []string{}
+	for _, x := range *ug.Users {
+		userLogins = append(userLogins, *x.Login)
+	}
+	d.Set("synced_user_logins", userLogins)
+
 	return nil
 }
 
@@ -106,6 +132,11 @@ func resourceExternalUserGroupCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(strconv.Itoa(*eug.ID))
 
+	_, _, err = client.ExternalUserGroups.Refresh(context.Background(), ugID, *eug.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceExternalUserGroupRead(ctx, d, meta)
 }
 
@@ -125,14 +156,22 @@ func resourceExternalUserGroupUpdate(ctx context.Context, d *schema.ResourceData
 		updateBody.ExternalUserGroup.Name = &name
 	}
 	if d.HasChange("auth_source_id") {
-		asID := d.Get("admin").(int)
+		asID := d.Get("auth_source_id").(int)
 		updateBody.ExternalUserGroup.AuthSourceID = &asID
 	}
 
-	_, _, err = client.ExternalUserGroups.Update(context.Background(), ugID, eugID, *updateBody)
+	if d.HasChanges("name", "auth_source_id") {
+		_, _, err = client.ExternalUserGroups.Update(context.Background(), ugID, eugID, *updateBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, _, err = client.ExternalUserGroups.Refresh(context.Background(), ugID, eugID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
 	return resourceExternalUserGroupRead(ctx, d, meta)
 }
 
@@ -155,3 +194,12 @@ func resourceExternalUserGroupDelete(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+func resourceExternalUserGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// marking the synced users as unknown forces an update, which triggers a refresh
+	if d.Id() != "" && d.Get("refresh_on_apply").(bool) {
+		return d.SetNewComputed("synced_user_logins")
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/umich-vci/gosatellite"
 )
 
@@ -20,6 +19,8 @@ func resourceFilter() *schema.Resource {
 		UpdateContext: resourceFilterUpdate,
 		DeleteContext: resourceFilterDelete,
 
+		CustomizeDiff: resourceFilterCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -39,11 +40,10 @@ func resourceFilter() *schema.Resource {
 				Required:    true,
 			},
 			"resource_type": {
-				Description:  "The resource type of the filter.  Once this is set, it cannot be changed without recreating the filter.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice(resourceTypeList, false),
+				Description: "The resource type of the filter. This must be a resource type present in the permission catalog of the Satellite server, which includes any resource types added by installed plugins. Once this is set, it cannot be changed without recreating the filter.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
 			},
 			"location_ids": {
 				Description: "A list of IDs of locations to associate with the filter. Unless `override` is set to `true` this should generally contain the `location_ids` that the parent role is associated with. It may also need to be set to an empty list if you desire the permission to be `unlimited`.",
@@ -283,38 +283,10 @@ func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	createBody.Filter.RoleID = &roleID
 
 	// validate the permission names passed in
-	permSearchOpts := new(gosatellite.PermissionsListOptions)
-
-	// For the miscellaneous role_type which has a value of null,
-	// I haven't figured out a way to search for resource_type=null
-	// So just get all permissions and then go through them all
-	if resourceType == "" {
-		permSearchOpts.PerPage = 400
-	} else {
-		permSearchOpts.Search = fmt.Sprintf("resource_type=%s", resourceType)
-	}
-	validPermissions, _, err := client.Permissions.List(context.Background(), *permSearchOpts)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	permNames := d.Get("permission_names").(*schema.Set).List()
-	permMap := make(map[string]int)
-	var permIDs []int
-	for _, x := range *validPermissions.Results {
-		if resourceType == "" {
-			if x.ResourceType == nil {
-				permMap[*x.Name] = *x.ID
-			}
-		} else {
-			permMap[*x.Name] = *x.ID
-		}
-	}
-	for x := range permNames {
-		if permID, ok := permMap[permNames[x].(string)]; ok {
-			permIDs = append(permIDs, permID)
-		} else {
-			return diag.Errorf("%s is not a valid permission for resource type %s", permNames[x].(string), resourceType)
-		}
+	permIDs, diags := filterPermissionIDs(client, resourceType, permNames)
+	if diags.HasError() {
+		return diags
 	}
 	createBody.Filter.PermissionIDs = &permIDs
 
@@ -357,7 +329,7 @@ func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(strconv.Itoa(*filter.ID))
 
-	return resourceFilterRead(ctx, d, meta)
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
 }
 
 func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -368,6 +340,7 @@ func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	resourceType := d.Get("resource_type").(string)
 
 	updateBody := new(gosatellite.FilterUpdate)
@@ -416,39 +389,11 @@ func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChange("permission_names") {
 		// validate the permission names passed in
-
-		// For the miscellaneous role_type which has a value of null,
-		// I haven't figured out a way to search for resource_type=null
-		// So just get all permissions and then go through them all
-		permSearchOpts := new(gosatellite.PermissionsListOptions)
-		if resourceType == "" {
-			permSearchOpts.PerPage = 400
-		} else {
-			permSearchOpts.Search = fmt.Sprintf("resource_type=%s", resourceType)
-		}
-
-		validPermissions, _, err := client.Permissions.List(context.Background(), *permSearchOpts)
-		if err != nil {
-			return diag.FromErr(err)
-		}
 		permNames := d.Get("permission_names").(*schema.Set).List()
-		permMap := make(map[string]int)
 		var permIDs []int
-		for _, x := range *validPermissions.Results {
-			if resourceType == "" {
-				if x.ResourceType == nil {
-					permMap[*x.Name] = *x.ID
-				}
-			} else {
-				permMap[*x.Name] = *x.ID
-			}
-		}
-		for x := range permNames {
-			if permID, ok := permMap[permNames[x].(string)]; ok {
-				permIDs = append(permIDs, permID)
-			} else {
-				return diag.Errorf("%s is not a valid permission for resource type %s", permNames[x].(string), resourceType)
-			}
+		permIDs, diags = filterPermissionIDs(client, resourceType, permNames)
+		if diags.HasError() {
+			return diags
 		}
 		updateBody.Filter.PermissionIDs = &permIDs
 	}
@@ -462,10 +407,10 @@ func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	_, _, err = client.Filters.Update(context.Background(), filterID, *updateBody)
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return resourceFilterRead(ctx, d, meta)
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
 }
 
 func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -486,6 +431,154 @@ func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceFilterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// resource_type may not be known yet if it is interpolated from another resource
+	if !d.NewValueKnown("resource_type") {
+		return nil
+	}
+
+	resourceType := d.Get("resource_type").(string)
+
+	if resourceType == "Location" && d.NewValueKnown("organization_ids") {
+		if _, ok := d.GetOk("organization_ids"); ok {
+			return fmt.Errorf("organization_ids cannot be specified for a resource_type of Location")
+		}
+	}
+
+	// only look up the permission catalog when there is something new to validate
+	if !d.HasChange("resource_type") && !d.HasChange("permission_names") {
+		return nil
+	}
+
+	if meta == nil {
+		return validateStaticResourceType(resourceType)
+	}
+
+	client := meta.(*apiClient).Client
+
+	// CustomizeDiff cannot return warnings, so fall back to the built in list here and
+	// let filterPermissionIDs report the unavailable catalog when the filter is applied.
+	catalog, err := filterPermissionCatalog(client)
+	if err != nil {
+		return validateStaticResourceType(resourceType)
+	}
+
+	if _, ok := catalog[resourceType]; !ok {
+		return fmt.Errorf("%q is not a valid resource_type on this Satellite server", resourceType)
+	}
+
+	if !d.NewValueKnown("permission_names") {
+		return nil
+	}
+
+	permNames := d.Get("permission_names").(*schema.Set).List()
+	_, err = lookupPermissionIDs(catalog, resourceType, permNames)
+
+	return err
+}
+
+// filterPermissionIDs returns the IDs of the permissions in permNames, verifying
+// that each of them is valid for resourceType. If the permission catalog cannot be
+// retrieved, only the permissions of resourceType are searched for and a warning is
+// returned, as resource_type could not be validated against the server.
+func filterPermissionIDs(client gosatellite.Client, resourceType string, permNames []interface{}) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	catalog, err := filterPermissionCatalog(client)
+	if err != nil {
+		// permissions without a resource type can only be found in the full catalog
+		if resourceType == "" {
+			return nil, diag.FromErr(err)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to retrieve the permission catalog",
+			Detail:   fmt.Sprintf("resource_type was only validated against the built in list of resource types: %s", err),
+		})
+
+		catalog, err = searchPermissionCatalog(client, fmt.Sprintf("resource_type=%s", resourceType))
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	permIDs, err := lookupPermissionIDs(catalog, resourceType, permNames)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	return permIDs, diags
+}
+
+func lookupPermissionIDs(catalog map[string]map[string]int, resourceType string, permNames []interface{}) ([]int, error) {
+	permIDs := []int{}
+	for x := range permNames {
+		if permID, ok := catalog[resourceType][permNames[x].(string)]; ok {
+			permIDs = append(permIDs, permID)
+		} else {
+			return nil, fmt.Errorf("%s is not a valid permission for resource type %s", permNames[x].(string), resourceType)
+		}
+	}
+
+	return permIDs, nil
+}
+
+// filterPermissionCatalog returns the permissions known to the Satellite server keyed
+// by resource type and then by permission name. Installed plugins add their own
+// resource types, so this is the authoritative list of valid filter resource types.
+// Permissions without a resource type are listed under the empty string.
+func filterPermissionCatalog(client gosatellite.Client) (map[string]map[string]int, error) {
+	// For the miscellaneous role_type which has a value of null,
+	// I haven't figured out a way to search for resource_type=null
+	// So just get all permissions and then go through them all
+	return searchPermissionCatalog(client, "")
+}
+
+// searchPermissionCatalog returns the permissions matching search in the same form as
+// filterPermissionCatalog.
+func searchPermissionCatalog(client gosatellite.Client, search string) (map[string]map[string]int, error) {
+	permSearchOpts := new(gosatellite.PermissionsListOptions)
+	permSearchOpts.Search = search
+
+	permissions, err := listAllPages(&permSearchOpts.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Permission, error) {
+		p, _, err := client.Permissions.List(context.Background(), *permSearchOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &p.ListResponse, p.Results, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make(map[string]map[string]int)
+	for _, x := range permissions {
+		resourceType := ""
+		if x.ResourceType != nil {
+			resourceType = *x.ResourceType
+		}
+		if _, ok := catalog[resourceType]; !ok {
+			catalog[resourceType] = make(map[string]int)
+		}
+		catalog[resourceType][*x.Name] = *x.ID
+	}
+
+	return catalog, nil
+}
+
+func validateStaticResourceType(resourceType string) error {
+	for _, x := range resourceTypeList {
+		if x == resourceType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q is not a known resource_type", resourceType)
+}
+
+// resourceTypeList is only used to validate resource_type when the permission
+// catalog cannot be retrieved from the Satellite server.
 var resourceTypeList = []string{
 	"",
 	"AnsibleRole",
@@ -0,0 +1,761 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+// parameterTypeList is the list of types a host or hostgroup parameter value can have.
+var parameterTypeList = []string{"string", "boolean", "integer", "real", "array", "hash", "yaml", "json"}
+
+func resourceHost() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource to manage a host in Red Hat Satellite. The host can be a record for a machine that registers itself, or a host that Satellite provisions.",
+
+		CreateContext: resourceHostCreate,
+		ReadContext:   resourceHostRead,
+		UpdateContext: resourceHostUpdate,
+		DeleteContext: resourceHostDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:      "The name of the host. If a short name is given and the host has a domain, Satellite reports the fully qualified name, which is not treated as a change.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: hostNameDiffSuppress,
+			},
+			"organization_id": {
+				Description: "The ID of the organization of the host.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"location_id": {
+				Description: "The ID of the location of the host.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"hostgroup_id": {
+				Description: "The ID of the hostgroup of the host. Attributes that are not set are inherited from the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"architecture_id": {
+				Description: "The ID of the architecture of the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"build": {
+				Description: "If set to true, the host is put into build mode so it is provisioned on its next boot. Satellite turns build mode off when provisioning finishes, so the value is only sent when it changes in the configuration and is never read back.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"comment": {
+				Description: "Additional information about the host.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"compute_attributes": {
+				Description:  "A JSON encoded object of attributes of the virtual machine passed to the compute resource, such as `{\"cpus\": 2, \"memory_mb\": 4096}`. The attributes depend on the type of the compute resource. Satellite does not return them, so changes made outside of Terraform are not detected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"compute_profile_id": {
+				Description: "The ID of the compute profile used to provision the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"compute_resource_id": {
+				Description: "The ID of the compute resource that Satellite provisions the host on. Changing this forces a new resource to be created.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"content_source_id": {
+				Description: "The ID of the Capsule that provides content to the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"content_view_id": {
+				Description: "The ID of the content view of the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"delete_action": {
+				Description:  "What happens to the host when the resource is destroyed. Valid values are `delete`, which deletes the host from Satellite, and `unregister`, which only unregisters the host as a content host and keeps its record.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "unregister"}, false),
+			},
+			"domain_id": {
+				Description: "The ID of the domain of the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"interface": {
+				Description: "A network interface of the host. Interfaces are matched to the interfaces of the host in order. When no interface is configured, the interfaces Satellite creates are reported.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the interface.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"attached_to": {
+							Description: "The identifier of the interface a virtual interface is attached to.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"domain_id": {
+							Description: "The ID of the domain of the interface.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+						"identifier": {
+							Description: "The device identifier of the interface, such as `eth0`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"ip": {
+							Description: "The IPv4 address of the interface. If not set, an address may be assigned from the subnet.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"mac": {
+							Description: "The MAC address of the interface. If not set, it is reported once the compute resource creates the interface.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"managed": {
+							Description: "Should Satellite manage DNS and DHCP for the interface?",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"name": {
+							Description: "The DNS name of the interface.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"primary": {
+							Description: "Is this the primary interface of the host? The primary interface is used for the name of the host.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"provision": {
+							Description: "Is this the interface used for provisioning?",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"subnet_id": {
+							Description: "The ID of the IPv4 subnet of the interface.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+						"type": {
+							Description:  "The type of the interface. Valid values are `interface`, `bmc`, `bond` and `bridge`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "interface",
+							ValidateFunc: validation.StringInSlice([]string{"interface", "bmc", "bond", "bridge"}, false),
+						},
+						"virtual": {
+							Description: "Is this a virtual interface, such as a VLAN or alias?",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"lifecycle_environment_id": {
+				Description: "The ID of the lifecycle environment of the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"managed": {
+				Description: "Should Satellite manage the provisioning of the host? Set to false for hosts that are only registered.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"operatingsystem_id": {
+				Description: "The ID of the operating system of the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"parameter": {
+				Description: "A parameter set on the host. Parameters inherited from the hostgroup, organization or location are not included. Parameters set outside of Terraform are removed.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the parameter.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"value": {
+							Description: "The value of the parameter.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"parameter_type": {
+							Description:  "The type of the value. Valid values are `string`, `boolean`, `integer`, `real`, `array`, `hash`, `yaml` and `json`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "string",
+							ValidateFunc: validation.StringInSlice(parameterTypeList, false),
+						},
+					},
+				},
+			},
+			"provision_method": {
+				Description:  "The method used to provision the host. Valid values are `build` and `image`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"build", "image"}, false),
+			},
+			"subnet_id": {
+				Description: "The ID of the IPv4 subnet of the primary interface of the host.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "A timestamp containing when the host was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hostgroup_title": {
+				Description: "The title of the hostgroup of the host, including the names of its parent hostgroups.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ip": {
+				Description: "The IPv4 address of the primary interface of the host.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mac": {
+				Description: "The MAC address of the primary interface of the host.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "A timestamp containing when the host was last changed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	hostID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	host, resp, err := client.Hosts.Get(context.Background(), hostID)
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", host.Name)
+	d.Set("organization_id", host.OrganizationID)
+	d.Set("location_id", host.LocationID)
+	d.Set("hostgroup_id", host.HostgroupID)
+	d.Set("hostgroup_title", host.HostgroupTitle)
+	d.Set("architecture_id", host.ArchitectureID)
+	d.Set("comment", host.Comment)
+	d.Set("compute_profile_id", host.ComputeProfileID)
+	d.Set("compute_resource_id", host.ComputeResourceID)
+	d.Set("domain_id", host.DomainID)
+	d.Set("managed", host.Managed)
+	d.Set("operatingsystem_id", host.OperatingsystemID)
+	d.Set("provision_method", host.ProvisionMethod)
+	d.Set("subnet_id", host.SubnetID)
+	d.Set("ip", host.IP)
+	d.Set("mac", host.MAC)
+	d.Set("created_at", host.CreatedAt)
+	d.Set("updated_at", host.UpdatedAt)
+
+	if host.ContentFacetAttributes != nil {
+		d.Set("content_source_id", host.ContentFacetAttributes.ContentSourceID)
+		d.Set("content_view_id", host.ContentFacetAttributes.ContentViewID)
+		d.Set("lifecycle_environment_id", host.ContentFacetAttributes.LifecycleEnvironmentID)
+	}
+
+	interfaceList := []map[string]interface{}{}
+	if host.Interfaces != nil {
+		for _, x := range *host.Interfaces {
+			iface := make(map[string]interface{})
+			iface["id"] = x.ID
+			iface["attached_to"] = x.AttachedTo
+			iface["domain_id"] = x.DomainID
+			iface["identifier"] = x.Identifier
+			iface["ip"] = x.IP
+			iface["mac"] = x.MAC
+			iface["managed"] = x.Managed
+			iface["name"] = x.Name
+			iface["primary"] = x.Primary
+			iface["provision"] = x.Provision
+			iface["subnet_id"] = x.SubnetID
+			iface["type"] = x.Type
+			iface["virtual"] = x.Virtual
+			interfaceList = append(interfaceList, iface)
+		}
+	}
+	d.Set("interface", interfaceList)
+
+	parameterList := []map[string]interface{}{}
+	if host.Parameters != nil {
+		for _, x := range *host.Parameters {
+			parameter := make(map[string]interface{})
+			parameter["name"] = x.Name
+			parameter["value"] = x.Value
+			parameter["parameter_type"] = x.ParameterType
+			parameterList = append(parameterList, parameter)
+		}
+	}
+	d.Set("parameter", parameterList)
+
+	return nil
+}
+
+func resourceHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	name := d.Get("name").(string)
+	orgID := d.Get("organization_id").(int)
+	locID := d.Get("location_id").(int)
+
+	createBody := new(gosatellite.HostCreate)
+	createBody.Host.Name = &name
+	createBody.Host.OrganizationID = &orgID
+	createBody.Host.LocationID = &locID
+
+	if hg, ok := d.GetOk("hostgroup_id"); ok {
+		hostgroupID := hg.(int)
+		createBody.Host.HostgroupID = &hostgroupID
+	}
+
+	if a, ok := d.GetOk("architecture_id"); ok {
+		architectureID := a.(int)
+		createBody.Host.ArchitectureID = &architectureID
+	}
+
+	if b, ok := d.GetOk("build"); ok {
+		build := b.(bool)
+		createBody.Host.Build = &build
+	}
+
+	if c, ok := d.GetOk("comment"); ok {
+		comment := c.(string)
+		createBody.Host.Comment = &comment
+	}
+
+	if ca, ok := d.GetOk("compute_attributes"); ok {
+		computeAttributes := make(map[string]interface{})
+		err := json.Unmarshal([]byte(ca.(string)), &computeAttributes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createBody.Host.ComputeAttributes = &computeAttributes
+	}
+
+	if cp, ok := d.GetOk("compute_profile_id"); ok {
+		computeProfileID := cp.(int)
+		createBody.Host.ComputeProfileID = &computeProfileID
+	}
+
+	if cr, ok := d.GetOk("compute_resource_id"); ok {
+		computeResourceID := cr.(int)
+		createBody.Host.ComputeResourceID = &computeResourceID
+	}
+
+	if dom, ok := d.GetOk("domain_id"); ok {
+		domainID := dom.(int)
+		createBody.Host.DomainID = &domainID
+	}
+
+	if !d.GetRawConfig().GetAttr("managed").IsNull() {
+		managed := d.Get("managed").(bool)
+		createBody.Host.Managed = &managed
+	}
+
+	if os, ok := d.GetOk("operatingsystem_id"); ok {
+		operatingsystemID := os.(int)
+		createBody.Host.OperatingsystemID = &operatingsystemID
+	}
+
+	if pm, ok := d.GetOk("provision_method"); ok {
+		provisionMethod := pm.(string)
+		createBody.Host.ProvisionMethod = &provisionMethod
+	}
+
+	if s, ok := d.GetOk("subnet_id"); ok {
+		subnetID := s.(int)
+		createBody.Host.SubnetID = &subnetID
+	}
+
+	contentFacet := hostContentFacetAttributes(d)
+	if contentFacet.ContentSourceID != nil || contentFacet.ContentViewID != nil || contentFacet.LifecycleEnvironmentID != nil {
+		createBody.Host.ContentFacetAttributes = contentFacet
+	}
+
+	if i, ok := d.GetOk("interface"); ok {
+		interfaces := hostInterfaceAttributes(nil, i.([]interface{}))
+		createBody.Host.InterfacesAttributes = &interfaces
+	}
+
+	if p, ok := d.GetOk("parameter"); ok {
+		parameters := hostParameterAttributes(nil, p.(*schema.Set).List())
+		createBody.Host.HostParametersAttributes = &parameters
+	}
+
+	host, _, err := client.Hosts.Create(context.Background(), *createBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*host.ID))
+
+	return resourceHostRead(ctx, d, meta)
+}
+
+func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	hostID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	update := false
+
+	updateBody := new(gosatellite.HostUpdate)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateBody.Host.Name = &name
+		update = true
+	}
+	if d.HasChange("organization_id") {
+		orgID := d.Get("organization_id").(int)
+		updateBody.Host.OrganizationID = &orgID
+		update = true
+	}
+	if d.HasChange("location_id") {
+		locID := d.Get("location_id").(int)
+		updateBody.Host.LocationID = &locID
+		update = true
+	}
+	// a removed hostgroup_id is sent as null below instead of 0
+	if d.HasChange("hostgroup_id") {
+		if hostgroupID := d.Get("hostgroup_id").(int); hostgroupID != 0 {
+			updateBody.Host.HostgroupID = &hostgroupID
+			update = true
+		}
+	}
+	if d.HasChange("architecture_id") {
+		architectureID := d.Get("architecture_id").(int)
+		updateBody.Host.ArchitectureID = &architectureID
+		update = true
+	}
+	if d.HasChange("build") {
+		build := d.Get("build").(bool)
+		updateBody.Host.Build = &build
+		update = true
+	}
+	if d.HasChange("comment") {
+		comment := d.Get("comment").(string)
+		updateBody.Host.Comment = &comment
+		update = true
+	}
+	if d.HasChange("compute_attributes") {
+		computeAttributes := make(map[string]interface{})
+		if ca, ok := d.GetOk("compute_attributes"); ok {
+			err := json.Unmarshal([]byte(ca.(string)), &computeAttributes)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		updateBody.Host.ComputeAttributes = &computeAttributes
+		update = true
+	}
+	if d.HasChange("compute_profile_id") {
+		computeProfileID := d.Get("compute_profile_id").(int)
+		updateBody.Host.ComputeProfileID = &computeProfileID
+		update = true
+	}
+	if d.HasChange("domain_id") {
+		domainID := d.Get("domain_id").(int)
+		updateBody.Host.DomainID = &domainID
+		update = true
+	}
+	if d.HasChange("managed") {
+		managed := d.Get("managed").(bool)
+		updateBody.Host.Managed = &managed
+		update = true
+	}
+	if d.HasChange("operatingsystem_id") {
+		operatingsystemID := d.Get("operatingsystem_id").(int)
+		updateBody.Host.OperatingsystemID = &operatingsystemID
+		update = true
+	}
+	if d.HasChange("provision_method") {
+		provisionMethod := d.Get("provision_method").(string)
+		updateBody.Host.ProvisionMethod = &provisionMethod
+		update = true
+	}
+	if d.HasChange("subnet_id") {
+		subnetID := d.Get("subnet_id").(int)
+		updateBody.Host.SubnetID = &subnetID
+		update = true
+	}
+	if d.HasChanges("content_source_id", "content_view_id", "lifecycle_environment_id") {
+		updateBody.Host.ContentFacetAttributes = hostContentFacetAttributes(d)
+		update = true
+	}
+	if d.HasChange("interface") {
+		oldInterfaces, newInterfaces := d.GetChange("interface")
+		interfaces := hostInterfaceAttributes(oldInterfaces.([]interface{}), newInterfaces.([]interface{}))
+		updateBody.Host.InterfacesAttributes = &interfaces
+		update = true
+	}
+	if d.HasChange("parameter") {
+		host, _, err := client.Hosts.Get(context.Background(), hostID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		currentParameters := []gosatellite.HostParameter{}
+		if host.Parameters != nil {
+			currentParameters = *host.Parameters
+		}
+
+		parameters := hostParameterAttributes(currentParameters, d.Get("parameter").(*schema.Set).List())
+		updateBody.Host.HostParametersAttributes = &parameters
+		update = true
+	}
+
+	if update {
+		_, _, err = client.Hosts.Update(context.Background(), hostID, *updateBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = setAttributesNull(client, fmt.Sprintf("api/hosts/%d", hostID), "host", clearedIDAttributes(d, "hostgroup_id"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHostRead(ctx, d, meta)
+}
+
+func resourceHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	hostID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("delete_action").(string) == "unregister" {
+		_, err = client.Hosts.Unregister(context.Background(), hostID)
+	} else {
+		_, err = client.Hosts.Delete(context.Background(), hostID)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// hostNameDiffSuppress ignores the domain Satellite appends to the name of a host when
+// the configuration uses the short name.
+func hostNameDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return !strings.Contains(new, ".") && strings.HasPrefix(strings.ToLower(old), strings.ToLower(new)+".")
+}
+
+// hostContentFacetAttributes returns the content facet attributes of the host that are
+// set in the configuration.
+func hostContentFacetAttributes(d *schema.ResourceData) *gosatellite.HostContentFacetAttributes {
+	contentFacet := new(gosatellite.HostContentFacetAttributes)
+
+	if cs, ok := d.GetOk("content_source_id"); ok {
+		contentSourceID := cs.(int)
+		contentFacet.ContentSourceID = &contentSourceID
+	}
+
+	if cv, ok := d.GetOk("content_view_id"); ok {
+		contentViewID := cv.(int)
+		contentFacet.ContentViewID = &contentViewID
+	}
+
+	if le, ok := d.GetOk("lifecycle_environment_id"); ok {
+		lifecycleEnvironmentID := le.(int)
+		contentFacet.LifecycleEnvironmentID = &lifecycleEnvironmentID
+	}
+
+	return contentFacet
+}
+
+// hostInterfaceAttributes builds the interfaces to send for the host. Each interface in
+// newInterfaces takes the ID of the interface in the same position of oldInterfaces so
+// it is updated in place, and interfaces beyond the end of newInterfaces are destroyed.
+func hostInterfaceAttributes(oldInterfaces []interface{}, newInterfaces []interface{}) []gosatellite.HostInterfaceAttributes {
+	interfaces := []gosatellite.HostInterfaceAttributes{}
+
+	for x := range newInterfaces {
+		iface := newInterfaces[x].(map[string]interface{})
+
+		attributes := gosatellite.HostInterfaceAttributes{}
+
+		if x < len(oldInterfaces) {
+			if id := oldInterfaces[x].(map[string]interface{})["id"].(int); id != 0 {
+				attributes.ID = &id
+			}
+		}
+
+		managed := iface["managed"].(bool)
+		attributes.Managed = &managed
+
+		primary := iface["primary"].(bool)
+		attributes.Primary = &primary
+
+		provision := iface["provision"].(bool)
+		attributes.Provision = &provision
+
+		ifaceType := iface["type"].(string)
+		attributes.Type = &ifaceType
+
+		virtual := iface["virtual"].(bool)
+		attributes.Virtual = &virtual
+
+		if attachedTo := iface["attached_to"].(string); attachedTo != "" {
+			attributes.AttachedTo = &attachedTo
+		}
+
+		if domainID := iface["domain_id"].(int); domainID != 0 {
+			attributes.DomainID = &domainID
+		}
+
+		if identifier := iface["identifier"].(string); identifier != "" {
+			attributes.Identifier = &identifier
+		}
+
+		if ip := iface["ip"].(string); ip != "" {
+			attributes.IP = &ip
+		}
+
+		if mac := iface["mac"].(string); mac != "" {
+			attributes.MAC = &mac
+		}
+
+		if name := iface["name"].(string); name != "" {
+			attributes.Name = &name
+		}
+
+		if subnetID := iface["subnet_id"].(int); subnetID != 0 {
+			attributes.SubnetID = &subnetID
+		}
+
+		interfaces = append(interfaces, attributes)
+	}
+
+	for x := len(newInterfaces); x < len(oldInterfaces); x++ {
+		if id := oldInterfaces[x].(map[string]interface{})["id"].(int); id != 0 {
+			destroy := true
+			interfaces = append(interfaces, gosatellite.HostInterfaceAttributes{ID: &id, Destroy: &destroy})
+		}
+	}
+
+	return interfaces
+}
+
+// hostParameterAttributes builds the parameters to send for the host. Parameters are
+// matched by name to the current parameters of the host so they are updated in place,
+// and current parameters that are no longer configured are destroyed.
+func hostParameterAttributes(currentParameters []gosatellite.HostParameter, newParameters []interface{}) []gosatellite.HostParameterAttributes {
+	parameters := []gosatellite.HostParameterAttributes{}
+
+	currentIDs := make(map[string]int)
+	for _, x := range currentParameters {
+		currentIDs[*x.Name] = *x.ID
+	}
+
+	wanted := make(map[string]bool)
+	for x := range newParameters {
+		parameter := newParameters[x].(map[string]interface{})
+
+		name := parameter["name"].(string)
+		value := parameter["value"].(string)
+		parameterType := parameter["parameter_type"].(string)
+		wanted[name] = true
+
+		attributes := gosatellite.HostParameterAttributes{
+			Name:          &name,
+			Value:         &value,
+			ParameterType: &parameterType,
+		}
+		if id, ok := currentIDs[name]; ok {
+			attributes.ID = &id
+		}
+
+		parameters = append(parameters, attributes)
+	}
+
+	for name, id := range currentIDs {
+		if !wanted[name] {
+			id := id
+			destroy := true
+			parameters = append(parameters, gosatellite.HostParameterAttributes{ID: &id, Destroy: &destroy})
+		}
+	}
+
+	return parameters
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -50,6 +51,24 @@ func resourceHostCollection() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"host_ids": {
+				Description:   "A list of IDs of hosts that are members of the host collection. Conflicts with `host_names`. Hosts added outside of Terraform, such as by an activation key, will be removed, and an empty list removes every host. If neither `host_ids` nor `host_names` is set, the hosts are left as they are in Satellite.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"host_names"},
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"host_names": {
+				Description:   "A list of names of hosts that are members of the host collection. Conflicts with `host_ids`. Hosts added outside of Terraform, such as by an activation key, will be removed, and an empty list removes every host. If neither `host_ids` nor `host_names` is set, the hosts are left as they are in Satellite.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"host_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"created_at": {
 				Description: "A timestamp containing when the host collection was created.",
 				Type:        schema.TypeString,
@@ -91,6 +110,43 @@ func resourceHostCollectionRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("created_at", hc.CreatedAt)
 	d.Set("updated_at", hc.UpdatedAt)
 
+	hostIDs := []int{}
+	if hc.HostIDs != nil {
+		hostIDs = *hc.HostIDs
+	}
+
+	if !hostCollectionManagesHosts(d) {
+		return nil
+	}
+
+	// only report the hosts in the form they are configured in
+	if !attributeConfigured(d, "host_names") {
+		d.Set("host_ids", hostIDs)
+		return nil
+	}
+
+	hostNames := []string{}
+	if len(hostIDs) > 0 {
+		opt := new(gosatellite.HostsListOptions)
+		opt.Search = fmt.Sprintf("host_collection_id = %d", hcID)
+
+		hosts, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Host, error) {
+			h, _, err := client.Hosts.List(context.Background(), opt)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &h.ListResponse, h.Results, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, x := range hosts {
+			hostNames = append(hostNames, *x.Name)
+		}
+	}
+	d.Set("host_names", hostNames)
+
 	return nil
 }
 
@@ -121,6 +177,18 @@ func resourceHostCollectionCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(strconv.Itoa(*hc.ID))
 
+	hostIDs, err := hostCollectionConfiguredHostIDs(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(hostIDs) > 0 {
+		_, _, err := client.HostCollections.AddHosts(context.Background(), *hc.ID, hostIDs)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceHostCollectionRead(ctx, d, meta)
 }
 
@@ -132,31 +200,67 @@ func resourceHostCollectionUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	updateBody := new(gosatellite.HostCollectionUpdate)
+	if d.HasChanges("name", "description", "max_hosts", "unlimited_hosts") {
+		updateBody := new(gosatellite.HostCollectionUpdate)
 
-	if d.HasChange("name") {
-		name := d.Get("name").(string)
-		updateBody.Name = &name
-	}
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateBody.Name = &name
+		}
 
-	if d.HasChange("description") {
-		description := d.Get("description").(string)
-		updateBody.Description = &description
-	}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateBody.Description = &description
+		}
 
-	if d.HasChange("max_hosts") {
-		maxHosts := d.Get("max_hosts").(int)
-		updateBody.MaxHosts = &maxHosts
-	}
+		if d.HasChange("max_hosts") {
+			maxHosts := d.Get("max_hosts").(int)
+			updateBody.MaxHosts = &maxHosts
+		}
+
+		if d.HasChange("unlimited_hosts") {
+			unlimited := d.Get("unlimited_hosts").(bool)
+			updateBody.UnlimitedHosts = &unlimited
+		}
 
-	if d.HasChange("unlimited_hosts") {
-		unlimited := d.Get("unlimited_hosts").(bool)
-		updateBody.UnlimitedHosts = &unlimited
+		_, _, err = client.HostCollections.Update(context.Background(), hcID, *updateBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	_, _, err = client.HostCollections.Update(context.Background(), hcID, *updateBody)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChanges("host_ids", "host_names") && hostCollectionManagesHosts(d) {
+		hc, _, err := client.HostCollections.Get(context.Background(), hcID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		currentHostIDs := []int{}
+		if hc.HostIDs != nil {
+			currentHostIDs = *hc.HostIDs
+		}
+
+		hostIDs, err := hostCollectionConfiguredHostIDs(client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		hostAddList, hostRemoveList := intListChanges(currentHostIDs, hostIDs)
+
+		// hosts are removed first so a collection at max_hosts can take the new hosts
+		if len(hostRemoveList) > 0 {
+			_, _, err := client.HostCollections.RemoveHosts(context.Background(), hcID, hostRemoveList)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if len(hostAddList) > 0 {
+			_, _, err := client.HostCollections.AddHosts(context.Background(), hcID, hostAddList)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return resourceHostCollectionRead(ctx, d, meta)
@@ -179,3 +283,55 @@ func resourceHostCollectionDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// hostCollectionManagesHosts reports whether the hosts of the host collection are managed,
+// which is the case when either host_ids or host_names is configured.
+func hostCollectionManagesHosts(d *schema.ResourceData) bool {
+	return attributeConfigured(d, "host_ids") || attributeConfigured(d, "host_names")
+}
+
+// hostCollectionConfiguredHostIDs returns the IDs of the hosts that should be members of
+// the host collection, looking up the IDs of the hosts in host_names if it is used.
+func hostCollectionConfiguredHostIDs(client gosatellite.Client, d *schema.ResourceData) ([]int, error) {
+	if !d.GetRawConfig().GetAttr("host_names").IsNull() {
+		return hostIDsFromNames(client, d.Get("host_names").(*schema.Set).List())
+	}
+
+	rawHostIDs := d.Get("host_ids").(*schema.Set).List()
+	hostIDs := []int{}
+	for x := range rawHostIDs {
+		hostIDs = append(hostIDs, rawHostIDs[x].(int))
+	}
+
+	return hostIDs, nil
+}
+
+// hostIDsFromNames looks up the IDs of the hosts with the given names.
+func hostIDsFromNames(client gosatellite.Client, names []interface{}) ([]int, error) {
+	hostIDs := []int{}
+	for x := range names {
+		name := names[x].(string)
+
+		opt := new(gosatellite.HostsListOptions)
+		opt.Search = fmt.Sprintf("name=\"%s\"", name)
+
+		hostList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Host, error) {
+			h, _, err := client.Hosts.List(context.Background(), opt)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &h.ListResponse, h.Results, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(hostList) != 1 {
+			return nil, fmt.Errorf("%d hosts found with name %s", len(hostList), name)
+		}
+
+		hostIDs = append(hostIDs, *hostList[0].ID)
+	}
+
+	return hostIDs, nil
+}
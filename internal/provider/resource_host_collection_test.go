@@ -30,3 +30,28 @@ resource "scaffolding_resource" "foo" {
   sample_attribute = "bar"
 }
 `
+
+func TestHostCollectionManagesHosts(t *testing.T) {
+	cases := []struct {
+		name    string
+		state   map[string]string
+		config  string
+		managed bool
+	}{
+		{"host_ids set", map[string]string{"name": "web", "organization_id": "1", "host_ids.#": "1", "host_ids.0": "3"}, `{"name": "web", "organization_id": 1, "host_ids": [3]}`, true},
+		{"host_names set", map[string]string{"name": "web", "organization_id": "1", "host_names.#": "1", "host_names.0": "web01.example.com"}, `{"name": "web", "organization_id": 1, "host_names": ["web01.example.com"]}`, true},
+		{"empty host_ids", map[string]string{"name": "web", "organization_id": "1"}, `{"name": "web", "organization_id": 1, "host_ids": []}`, true},
+		{"unset", map[string]string{"name": "web", "organization_id": "1"}, `{"name": "web", "organization_id": 1}`, false},
+		{"unset after being managed", map[string]string{"name": "web", "organization_id": "1", "host_ids.#": "1", "host_ids.0": "3"}, `{"name": "web", "organization_id": 1}`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := testResourceData(t, resourceHostCollection(), c.state, c.config)
+
+			if managed := hostCollectionManagesHosts(d); managed != c.managed {
+				t.Fatalf("expected the hosts to be managed: %t, got %t", c.managed, managed)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/umich-vci/gosatellite"
+)
+
+func TestAccResourceHost(t *testing.T) {
+	t.Skip("resource not yet implemented, remove this once you add your own code")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceHost,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"scaffolding_resource.foo", "sample_attribute", regexp.MustCompile("^ba")),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceHost = `
+resource "scaffolding_resource" "foo" {
+  sample_attribute = "bar"
+}
+`
+
+func TestHostNameDiffSuppress(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"short name of the FQDN", "web01.example.com", "web01", true},
+		{"different case", "WEB01.example.com", "web01", true},
+		{"same FQDN", "web01.example.com", "web01.example.com", false},
+		{"different FQDN", "web01.example.com", "web01.example.org", false},
+		{"different short name", "web01.example.com", "web02", false},
+		{"prefix of the short name", "web01.example.com", "web0", false},
+		{"new host", "", "web01", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if suppress := hostNameDiffSuppress("name", c.old, c.new, nil); suppress != c.suppress {
+				t.Fatalf("hostNameDiffSuppress(%q, %q) = %t, expected %t", c.old, c.new, suppress, c.suppress)
+			}
+		})
+	}
+}
+
+func TestHostInterfaceAttributes(t *testing.T) {
+	iface := func(id int, identifier string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":          id,
+			"attached_to": "",
+			"domain_id":   0,
+			"identifier":  identifier,
+			"ip":          "",
+			"mac":         "",
+			"managed":     true,
+			"name":        "",
+			"primary":     identifier == "eth0",
+			"provision":   identifier == "eth0",
+			"subnet_id":   0,
+			"type":        "interface",
+			"virtual":     false,
+		}
+	}
+
+	type sent struct {
+		id         int
+		identifier string
+		destroy    bool
+	}
+
+	cases := []struct {
+		name string
+		old  []interface{}
+		new  []interface{}
+		sent []sent
+	}{
+		{
+			name: "unchanged",
+			old:  []interface{}{iface(1, "eth0"), iface(2, "eth1")},
+			new:  []interface{}{iface(0, "eth0"), iface(0, "eth1")},
+			sent: []sent{{1, "eth0", false}, {2, "eth1", false}},
+		},
+		{
+			name: "interface added",
+			old:  []interface{}{iface(1, "eth0")},
+			new:  []interface{}{iface(0, "eth0"), iface(0, "eth1")},
+			sent: []sent{{1, "eth0", false}, {0, "eth1", false}},
+		},
+		{
+			name: "last interface removed",
+			old:  []interface{}{iface(1, "eth0"), iface(2, "eth1")},
+			new:  []interface{}{iface(0, "eth0")},
+			sent: []sent{{1, "eth0", false}, {2, "", true}},
+		},
+		{
+			name: "interfaces reordered",
+			old:  []interface{}{iface(1, "eth0"), iface(2, "eth1")},
+			new:  []interface{}{iface(0, "eth1"), iface(0, "eth0")},
+			sent: []sent{{1, "eth1", false}, {2, "eth0", false}},
+		},
+		{
+			name: "interface without an ID",
+			old:  []interface{}{iface(0, "eth0")},
+			new:  []interface{}{},
+			sent: []sent{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			interfaces := hostInterfaceAttributes(c.old, c.new)
+			if len(interfaces) != len(c.sent) {
+				t.Fatalf("expected %d interfaces, got %d", len(c.sent), len(interfaces))
+			}
+
+			for x, want := range c.sent {
+				got := interfaces[x]
+				id := 0
+				if got.ID != nil {
+					id = *got.ID
+				}
+				identifier := ""
+				if got.Identifier != nil {
+					identifier = *got.Identifier
+				}
+				destroy := got.Destroy != nil && *got.Destroy
+
+				if id != want.id || identifier != want.identifier || destroy != want.destroy {
+					t.Fatalf("interface %d: expected %+v, got {id:%d identifier:%s destroy:%t}", x, want, id, identifier, destroy)
+				}
+			}
+		})
+	}
+}
+
+func TestHostParameterAttributes(t *testing.T) {
+	current := func(id int, name string) gosatellite.HostParameter {
+		value := "value"
+		parameterType := "string"
+		return gosatellite.HostParameter{ID: &id, Name: &name, Value: &value, ParameterType: &parameterType}
+	}
+	parameter := func(name string, value string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "value": value, "parameter_type": "string"}
+	}
+
+	cases := []struct {
+		name    string
+		current []gosatellite.HostParameter
+		new     []interface{}
+		sent    []string
+	}{
+		{
+			name:    "value changed",
+			current: []gosatellite.HostParameter{current(1, "role")},
+			new:     []interface{}{parameter("role", "db")},
+			sent:    []string{"1 role=db"},
+		},
+		{
+			name:    "parameter added",
+			current: []gosatellite.HostParameter{current(1, "role")},
+			new:     []interface{}{parameter("role", "web"), parameter("tier", "prod")},
+			sent:    []string{"0 tier=prod", "1 role=web"},
+		},
+		{
+			name:    "parameter renamed",
+			current: []gosatellite.HostParameter{current(1, "role")},
+			new:     []interface{}{parameter("function", "web")},
+			sent:    []string{"0 function=web", "1 destroy"},
+		},
+		{
+			name:    "parameter deleted",
+			current: []gosatellite.HostParameter{current(1, "role"), current(2, "tier")},
+			new:     []interface{}{parameter("role", "web")},
+			sent:    []string{"1 role=web", "2 destroy"},
+		},
+		{
+			name:    "every parameter deleted",
+			current: []gosatellite.HostParameter{current(1, "role")},
+			new:     []interface{}{},
+			sent:    []string{"1 destroy"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sent := []string{}
+			for _, x := range hostParameterAttributes(c.current, c.new) {
+				id := 0
+				if x.ID != nil {
+					id = *x.ID
+				}
+				if x.Destroy != nil && *x.Destroy {
+					sent = append(sent, fmt.Sprintf("%d destroy", id))
+					continue
+				}
+				sent = append(sent, fmt.Sprintf("%d %s=%s", id, *x.Name, *x.Value))
+			}
+			sort.Strings(sent)
+
+			if strings.Join(sent, ", ") != strings.Join(c.sent, ", ") {
+				t.Fatalf("expected %v, got %v", c.sent, sent)
+			}
+		})
+	}
+}
+
+func TestHostClearedHostgroup(t *testing.T) {
+	state := map[string]string{"name": "web01", "organization_id": "1", "location_id": "2", "hostgroup_id": "3"}
+
+	cases := []struct {
+		name    string
+		config  string
+		cleared []string
+	}{
+		{"hostgroup kept", `{"name": "web01", "organization_id": 1, "location_id": 2, "hostgroup_id": 3}`, []string{}},
+		{"hostgroup changed", `{"name": "web01", "organization_id": 1, "location_id": 2, "hostgroup_id": 4}`, []string{}},
+		{"hostgroup removed", `{"name": "web01", "organization_id": 1, "location_id": 2}`, []string{"hostgroup_id"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := testResourceData(t, resourceHost(), state, c.config)
+
+			cleared := clearedIDAttributes(d, "hostgroup_id")
+			if strings.Join(cleared, ",") != strings.Join(c.cleared, ",") {
+				t.Fatalf("expected %v to be cleared, got %v", c.cleared, cleared)
+			}
+		})
+	}
+}
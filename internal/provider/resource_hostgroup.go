@@ -0,0 +1,533 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+// Katello reads the activation keys used to register hosts of a hostgroup from this parameter.
+const activationKeysParameter = "kt_activation_keys"
+
+func resourceHostgroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource to manage a hostgroup in Red Hat Satellite.",
+
+		CreateContext: resourceHostgroupCreate,
+		ReadContext:   resourceHostgroupRead,
+		UpdateContext: resourceHostgroupUpdate,
+		DeleteContext: resourceHostgroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHostgroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the hostgroup, without the names of its parent hostgroups.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"parent_id": {
+				Description: "The ID of the parent hostgroup. Attributes that are not set are inherited from the parent hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"activation_keys": {
+				Description: "A list of names of activation keys used to register hosts of the hostgroup. Keys later in the list take precedence. This is stored in the `kt_activation_keys` parameter of the hostgroup.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"ansible_role_ids": {
+				Description: "A list of IDs of Ansible roles to assign to the hostgroup.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"architecture_id": {
+				Description: "The ID of the architecture of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"content_source_id": {
+				Description: "The ID of the Capsule that provides content to hosts of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"content_view_id": {
+				Description: "The ID of the content view of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"description": {
+				Description: "A description of the hostgroup.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"domain_id": {
+				Description: "The ID of the domain of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"lifecycle_environment_id": {
+				Description: "The ID of the lifecycle environment of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"location_ids": {
+				Description: "A list of IDs of locations the hostgroup is available in.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"medium_id": {
+				Description: "The ID of the installation medium of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"operatingsystem_id": {
+				Description: "The ID of the operating system of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"organization_ids": {
+				Description: "A list of IDs of organizations the hostgroup is available in.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"parameter": {
+				Description: "A parameter set on the hostgroup. Parameters inherited from a parent hostgroup are not included. Parameters set outside of Terraform are removed. Use `activation_keys` instead of a `kt_activation_keys` parameter.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "The name of the parameter.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringNotInSlice([]string{activationKeysParameter}, false),
+						},
+						"value": {
+							Description: "The value of the parameter.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"parameter_type": {
+							Description:  "The type of the value. Valid values are `string`, `boolean`, `integer`, `real`, `array`, `hash`, `yaml` and `json`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "string",
+							ValidateFunc: validation.StringInSlice(parameterTypeList, false),
+						},
+					},
+				},
+			},
+			"ptable_id": {
+				Description: "The ID of the partition table of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"subnet_id": {
+				Description: "The ID of the IPv4 subnet of the hostgroup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"created_at": {
+				Description: "A timestamp containing when the hostgroup was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"title": {
+				Description: "The title of the hostgroup, which is its name prefixed with the names of its parent hostgroups, such as `Base/RHEL9/Web`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "A timestamp containing when the hostgroup was last changed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceHostgroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	hgID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostgroup, resp, err := client.Hostgroups.Get(context.Background(), hgID)
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", hostgroup.Name)
+	d.Set("title", hostgroup.Title)
+	d.Set("parent_id", hostgroup.ParentID)
+	d.Set("architecture_id", hostgroup.ArchitectureID)
+	d.Set("content_source_id", hostgroup.ContentSourceID)
+	d.Set("content_view_id", hostgroup.ContentViewID)
+	d.Set("description", hostgroup.Description)
+	d.Set("domain_id", hostgroup.DomainID)
+	d.Set("lifecycle_environment_id", hostgroup.LifecycleEnvironmentID)
+	d.Set("medium_id", hostgroup.MediumID)
+	d.Set("operatingsystem_id", hostgroup.OperatingsystemID)
+	d.Set("ptable_id", hostgroup.PtableID)
+	d.Set("subnet_id", hostgroup.SubnetID)
+	d.Set("created_at", hostgroup.CreatedAt)
+	d.Set("updated_at", hostgroup.UpdatedAt)
+
+	ansibleRoleIDs := []int{}
+	if hostgroup.AnsibleRoles != nil {
+		for _, x := range *hostgroup.AnsibleRoles {
+			ansibleRoleIDs = append(ansibleRoleIDs, *x.ID)
+		}
+	}
+	d.Set("ansible_role_ids", ansibleRoleIDs)
+
+	locationIDs := []int{}
+	for _, x := range *hostgroup.Locations {
+		locationIDs = append(locationIDs, *x.ID)
+	}
+	d.Set("location_ids", locationIDs)
+
+	organizationIDs := []int{}
+	for _, x := range *hostgroup.Organizations {
+		organizationIDs = append(organizationIDs, *x.ID)
+	}
+	d.Set("organization_ids", organizationIDs)
+
+	activationKeys := []string{}
+	parameterList := []map[string]interface{}{}
+	if hostgroup.Parameters != nil {
+		for _, x := range *hostgroup.Parameters {
+			if *x.Name == activationKeysParameter {
+				for _, key := range strings.Split(*x.Value, ",") {
+					if key = strings.TrimSpace(key); key != "" {
+						activationKeys = append(activationKeys, key)
+					}
+				}
+				continue
+			}
+
+			parameter := make(map[string]interface{})
+			parameter["name"] = x.Name
+			parameter["value"] = x.Value
+			parameter["parameter_type"] = x.ParameterType
+			parameterList = append(parameterList, parameter)
+		}
+	}
+	d.Set("activation_keys", activationKeys)
+	d.Set("parameter", parameterList)
+
+	return nil
+}
+
+func resourceHostgroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	name := d.Get("name").(string)
+
+	createBody := new(gosatellite.HostgroupCreate)
+	createBody.Hostgroup.Name = &name
+
+	if p, ok := d.GetOk("parent_id"); ok {
+		parentID := p.(int)
+		createBody.Hostgroup.ParentID = &parentID
+	}
+
+	if ar, ok := d.GetOk("ansible_role_ids"); ok {
+		rawAnsibleRoleIDs := ar.(*schema.Set).List()
+		ansibleRoleIDs := []int{}
+		for x := range rawAnsibleRoleIDs {
+			ansibleRoleIDs = append(ansibleRoleIDs, rawAnsibleRoleIDs[x].(int))
+		}
+		createBody.Hostgroup.AnsibleRoleIDs = &ansibleRoleIDs
+	}
+
+	if a, ok := d.GetOk("architecture_id"); ok {
+		architectureID := a.(int)
+		createBody.Hostgroup.ArchitectureID = &architectureID
+	}
+
+	if cs, ok := d.GetOk("content_source_id"); ok {
+		contentSourceID := cs.(int)
+		createBody.Hostgroup.ContentSourceID = &contentSourceID
+	}
+
+	if cv, ok := d.GetOk("content_view_id"); ok {
+		contentViewID := cv.(int)
+		createBody.Hostgroup.ContentViewID = &contentViewID
+	}
+
+	if desc, ok := d.GetOk("description"); ok {
+		description := desc.(string)
+		createBody.Hostgroup.Description = &description
+	}
+
+	if dom, ok := d.GetOk("domain_id"); ok {
+		domainID := dom.(int)
+		createBody.Hostgroup.DomainID = &domainID
+	}
+
+	if le, ok := d.GetOk("lifecycle_environment_id"); ok {
+		lifecycleEnvironmentID := le.(int)
+		createBody.Hostgroup.LifecycleEnvironmentID = &lifecycleEnvironmentID
+	}
+
+	if loc, ok := d.GetOk("location_ids"); ok {
+		rawLocationIDs := loc.(*schema.Set).List()
+		locationIDs := []int{}
+		for x := range rawLocationIDs {
+			locationIDs = append(locationIDs, rawLocationIDs[x].(int))
+		}
+		createBody.Hostgroup.LocationIDs = &locationIDs
+	}
+
+	if m, ok := d.GetOk("medium_id"); ok {
+		mediumID := m.(int)
+		createBody.Hostgroup.MediumID = &mediumID
+	}
+
+	if os, ok := d.GetOk("operatingsystem_id"); ok {
+		operatingsystemID := os.(int)
+		createBody.Hostgroup.OperatingsystemID = &operatingsystemID
+	}
+
+	if org, ok := d.GetOk("organization_ids"); ok {
+		rawOrganizationIDs := org.(*schema.Set).List()
+		organizationIDs := []int{}
+		for x := range rawOrganizationIDs {
+			organizationIDs = append(organizationIDs, rawOrganizationIDs[x].(int))
+		}
+		createBody.Hostgroup.OrganizationIDs = &organizationIDs
+	}
+
+	if pt, ok := d.GetOk("ptable_id"); ok {
+		ptableID := pt.(int)
+		createBody.Hostgroup.PtableID = &ptableID
+	}
+
+	if s, ok := d.GetOk("subnet_id"); ok {
+		subnetID := s.(int)
+		createBody.Hostgroup.SubnetID = &subnetID
+	}
+
+	parameters := hostParameterAttributes(nil, hostgroupConfiguredParameters(d))
+	if len(parameters) > 0 {
+		createBody.Hostgroup.GroupParametersAttributes = &parameters
+	}
+
+	hostgroup, _, err := client.Hostgroups.Create(context.Background(), *createBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*hostgroup.ID))
+
+	return resourceHostgroupRead(ctx, d, meta)
+}
+
+func resourceHostgroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	hgID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateBody := new(gosatellite.HostgroupUpdate)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateBody.Hostgroup.Name = &name
+	}
+	if d.HasChange("parent_id") {
+		parentID := d.Get("parent_id").(int)
+		updateBody.Hostgroup.ParentID = &parentID
+	}
+	if d.HasChange("ansible_role_ids") {
+		rawAnsibleRoleIDs := d.Get("ansible_role_ids").(*schema.Set).List()
+		ansibleRoleIDs := []int{}
+		for x := range rawAnsibleRoleIDs {
+			ansibleRoleIDs = append(ansibleRoleIDs, rawAnsibleRoleIDs[x].(int))
+		}
+		updateBody.Hostgroup.AnsibleRoleIDs = &ansibleRoleIDs
+	}
+	if d.HasChange("architecture_id") {
+		architectureID := d.Get("architecture_id").(int)
+		updateBody.Hostgroup.ArchitectureID = &architectureID
+	}
+	if d.HasChange("content_source_id") {
+		contentSourceID := d.Get("content_source_id").(int)
+		updateBody.Hostgroup.ContentSourceID = &contentSourceID
+	}
+	if d.HasChange("content_view_id") {
+		contentViewID := d.Get("content_view_id").(int)
+		updateBody.Hostgroup.ContentViewID = &contentViewID
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateBody.Hostgroup.Description = &description
+	}
+	if d.HasChange("domain_id") {
+		domainID := d.Get("domain_id").(int)
+		updateBody.Hostgroup.DomainID = &domainID
+	}
+	if d.HasChange("lifecycle_environment_id") {
+		lifecycleEnvironmentID := d.Get("lifecycle_environment_id").(int)
+		updateBody.Hostgroup.LifecycleEnvironmentID = &lifecycleEnvironmentID
+	}
+	if d.HasChange("location_ids") {
+		rawLocationIDs := d.Get("location_ids").(*schema.Set).List()
+		locationIDs := []int{}
+		for x := range rawLocationIDs {
+			locationIDs = append(locationIDs, rawLocationIDs[x].(int))
+		}
+		updateBody.Hostgroup.LocationIDs = &locationIDs
+	}
+	if d.HasChange("medium_id") {
+		mediumID := d.Get("medium_id").(int)
+		updateBody.Hostgroup.MediumID = &mediumID
+	}
+	if d.HasChange("operatingsystem_id") {
+		operatingsystemID := d.Get("operatingsystem_id").(int)
+		updateBody.Hostgroup.OperatingsystemID = &operatingsystemID
+	}
+	if d.HasChange("organization_ids") {
+		rawOrganizationIDs := d.Get("organization_ids").(*schema.Set).List()
+		organizationIDs := []int{}
+		for x := range rawOrganizationIDs {
+			organizationIDs = append(organizationIDs, rawOrganizationIDs[x].(int))
+		}
+		updateBody.Hostgroup.OrganizationIDs = &organizationIDs
+	}
+	if d.HasChange("ptable_id") {
+		ptableID := d.Get("ptable_id").(int)
+		updateBody.Hostgroup.PtableID = &ptableID
+	}
+	if d.HasChange("subnet_id") {
+		subnetID := d.Get("subnet_id").(int)
+		updateBody.Hostgroup.SubnetID = &subnetID
+	}
+	if d.HasChanges("activation_keys", "parameter") {
+		hostgroup, _, err := client.Hostgroups.Get(context.Background(), hgID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		currentParameters := []gosatellite.HostParameter{}
+		if hostgroup.Parameters != nil {
+			currentParameters = *hostgroup.Parameters
+		}
+
+		parameters := hostParameterAttributes(currentParameters, hostgroupConfiguredParameters(d))
+		updateBody.Hostgroup.GroupParametersAttributes = &parameters
+	}
+
+	_, _, err = client.Hostgroups.Update(context.Background(), hgID, *updateBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHostgroupRead(ctx, d, meta)
+}
+
+func resourceHostgroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	hgID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Hostgroups.Delete(context.Background(), hgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceHostgroupImport allows a hostgroup to be imported by its title, such as
+// `Base/RHEL9/Web`, as well as by its ID.
+func resourceHostgroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*apiClient).Client
+
+	opt := new(gosatellite.HostgroupsListOptions)
+	opt.Search = fmt.Sprintf("title=\"%s\"", d.Id())
+
+	hostgroupList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Hostgroup, error) {
+		h, _, err := client.Hostgroups.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &h.ListResponse, h.Results, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hostgroupList) != 1 {
+		return nil, fmt.Errorf("%d hostgroups found with title %s", len(hostgroupList), d.Id())
+	}
+
+	d.SetId(strconv.Itoa(*hostgroupList[0].ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// hostgroupConfiguredParameters returns the configured parameters of the hostgroup,
+// including the parameter holding activation_keys when it is set.
+func hostgroupConfiguredParameters(d *schema.ResourceData) []interface{} {
+	parameters := d.Get("parameter").(*schema.Set).List()
+
+	rawActivationKeys := d.Get("activation_keys").([]interface{})
+	if len(rawActivationKeys) > 0 {
+		activationKeys := []string{}
+		for x := range rawActivationKeys {
+			activationKeys = append(activationKeys, rawActivationKeys[x].(string))
+		}
+
+		parameter := make(map[string]interface{})
+		parameter["name"] = activationKeysParameter
+		parameter["value"] = strings.Join(activationKeys, ",")
+		parameter["parameter_type"] = "string"
+		parameters = append(parameters, parameter)
+	}
+
+	return parameters
+}
@@ -40,6 +40,15 @@ func resourceLocation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 			},
+			"compute_resource_ids":      taxonomyAssociationSchema("A list of IDs of compute resources to associate with the location."),
+			"domain_ids":                taxonomyAssociationSchema("A list of IDs of domains to associate with the location."),
+			"hostgroup_ids":             taxonomyAssociationSchema("A list of IDs of hostgroups to associate with the location."),
+			"medium_ids":                taxonomyAssociationSchema("A list of IDs of installation media to associate with the location."),
+			"organization_ids":          taxonomyAssociationSchema("A list of IDs of organizations to associate with the location."),
+			"provisioning_template_ids": taxonomyAssociationSchema("A list of IDs of provisioning templates to associate with the location."),
+			"smart_proxy_ids":           taxonomyAssociationSchema("A list of IDs of smart proxies and capsules to associate with the location."),
+			"subnet_ids":                taxonomyAssociationSchema("A list of IDs of subnets to associate with the location."),
+			"user_ids":                  taxonomyAssociationSchema("A list of IDs of users to associate with the location."),
 		},
 	}
 }
@@ -66,6 +75,15 @@ func resourceLocationRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("name", location.Name)
 	d.Set("description", location.Description)
 	d.Set("parent_id", location.ParentID)
+	setTaxonomyAssociationIDs(d, "compute_resource_ids", location.ComputeResources)
+	setTaxonomyAssociationIDs(d, "domain_ids", location.Domains)
+	setTaxonomyAssociationIDs(d, "hostgroup_ids", location.Hostgroups)
+	setTaxonomyAssociationIDs(d, "medium_ids", location.Media)
+	setTaxonomyAssociationIDs(d, "organization_ids", location.Organizations)
+	setTaxonomyAssociationIDs(d, "provisioning_template_ids", location.ProvisioningTemplates)
+	setTaxonomyAssociationIDs(d, "smart_proxy_ids", location.SmartProxies)
+	setTaxonomyAssociationIDs(d, "subnet_ids", location.Subnets)
+	setTaxonomyAssociationIDs(d, "user_ids", location.Users)
 
 	return nil
 }
@@ -87,6 +105,16 @@ func resourceLocationCreate(ctx context.Context, d *schema.ResourceData, meta in
 		createBody.Location.ParentID = &parentID
 	}
 
+	createBody.Location.ComputeResourceIDs = taxonomyAssociationIDs(d, "compute_resource_ids")
+	createBody.Location.DomainIDs = taxonomyAssociationIDs(d, "domain_ids")
+	createBody.Location.HostgroupIDs = taxonomyAssociationIDs(d, "hostgroup_ids")
+	createBody.Location.MediumIDs = taxonomyAssociationIDs(d, "medium_ids")
+	createBody.Location.OrganizationIDs = taxonomyAssociationIDs(d, "organization_ids")
+	createBody.Location.ProvisioningTemplateIDs = taxonomyAssociationIDs(d, "provisioning_template_ids")
+	createBody.Location.SmartProxyIDs = taxonomyAssociationIDs(d, "smart_proxy_ids")
+	createBody.Location.SubnetIDs = taxonomyAssociationIDs(d, "subnet_ids")
+	createBody.Location.UserIDs = taxonomyAssociationIDs(d, "user_ids")
+
 	location, _, err := client.Locations.Create(context.Background(), *createBody)
 	if err != nil {
 		return diag.FromErr(err)
@@ -119,6 +147,16 @@ func resourceLocationUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		updateBody.Location.ParentID = &parentID
 	}
 
+	updateBody.Location.ComputeResourceIDs = taxonomyAssociationIDs(d, "compute_resource_ids")
+	updateBody.Location.DomainIDs = taxonomyAssociationIDs(d, "domain_ids")
+	updateBody.Location.HostgroupIDs = taxonomyAssociationIDs(d, "hostgroup_ids")
+	updateBody.Location.MediumIDs = taxonomyAssociationIDs(d, "medium_ids")
+	updateBody.Location.OrganizationIDs = taxonomyAssociationIDs(d, "organization_ids")
+	updateBody.Location.ProvisioningTemplateIDs = taxonomyAssociationIDs(d, "provisioning_template_ids")
+	updateBody.Location.SmartProxyIDs = taxonomyAssociationIDs(d, "smart_proxy_ids")
+	updateBody.Location.SubnetIDs = taxonomyAssociationIDs(d, "subnet_ids")
+	updateBody.Location.UserIDs = taxonomyAssociationIDs(d, "user_ids")
+
 	_, _, err = client.Locations.Update(context.Background(), locationID, *updateBody)
 	if err != nil {
 		return diag.FromErr(err)
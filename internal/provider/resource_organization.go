@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/umich-vci/gosatellite"
 )
 
@@ -39,6 +40,73 @@ func resourceOrganization() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"cdn_configuration": {
+				Description: "The configuration of where the organization syncs Red Hat content from. If not set, the configuration of the Satellite server is not changed.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Where Red Hat content is synced from. Valid values are `redhat_cdn` for the Red Hat CDN, `custom_cdn` for a mirror of the Red Hat CDN, `network_sync` for an upstream Satellite server and `export_sync` for an air gapped Satellite server that imports content exports.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"redhat_cdn", "custom_cdn", "network_sync", "export_sync"}, false),
+						},
+						"password": {
+							Description: "The password used to authenticate to the upstream Satellite server when `type` is `network_sync`. This is a write-only argument that requires Terraform 1.11 or later. It is never stored in the state, so it is only sent when the rest of the configuration or `password_version` changes.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+						},
+						"password_version": {
+							Description: "An arbitrary number that is changed to send `password` to Satellite again.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"ssl_ca_credential_id": {
+							Description: "The ID of the content credential holding the CA certificate of the CDN or upstream Satellite server.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"upstream_content_view_label": {
+							Description: "The label of the content view to sync from on the upstream Satellite server when `type` is `network_sync`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"upstream_lifecycle_environment_label": {
+							Description: "The label of the lifecycle environment to sync from on the upstream Satellite server when `type` is `network_sync`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"upstream_organization_label": {
+							Description: "The label of the organization to sync from on the upstream Satellite server when `type` is `network_sync`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"url": {
+							Description: "The URL of the CDN or upstream Satellite server. Not used when `type` is `export_sync`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"username": {
+							Description: "The username used to authenticate to the upstream Satellite server when `type` is `network_sync`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"simple_content_access": {
+				Description: "Is Simple Content Access enabled for the organization? Satellite 6.16 and later only support Simple Content Access.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 			"hosts_count": {
 				Description: "A count of how many hosts are registered to the organization.",
 				Type:        schema.TypeInt,
@@ -49,6 +117,15 @@ func resourceOrganization() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"compute_resource_ids":      taxonomyAssociationSchema("A list of IDs of compute resources to associate with the organization."),
+			"domain_ids":                taxonomyAssociationSchema("A list of IDs of domains to associate with the organization."),
+			"hostgroup_ids":             taxonomyAssociationSchema("A list of IDs of hostgroups to associate with the organization."),
+			"location_ids":              taxonomyAssociationSchema("A list of IDs of locations to associate with the organization."),
+			"medium_ids":                taxonomyAssociationSchema("A list of IDs of installation media to associate with the organization."),
+			"provisioning_template_ids": taxonomyAssociationSchema("A list of IDs of provisioning templates to associate with the organization."),
+			"smart_proxy_ids":           taxonomyAssociationSchema("A list of IDs of smart proxies and capsules to associate with the organization."),
+			"subnet_ids":                taxonomyAssociationSchema("A list of IDs of subnets to associate with the organization."),
+			"user_ids":                  taxonomyAssociationSchema("A list of IDs of users to associate with the organization."),
 		},
 	}
 }
@@ -72,11 +149,37 @@ func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	// the configuration is only reported once it is managed, as it is left alone otherwise
+	cdnConfiguration := []map[string]interface{}{}
+	if org.CDNConfiguration != nil && len(d.Get("cdn_configuration").([]interface{})) > 0 {
+		cdn := make(map[string]interface{})
+		cdn["type"] = org.CDNConfiguration.Type
+		cdn["password_version"] = d.Get("cdn_configuration.0.password_version")
+		cdn["ssl_ca_credential_id"] = org.CDNConfiguration.SSLCACredentialID
+		cdn["upstream_content_view_label"] = org.CDNConfiguration.UpstreamContentViewLabel
+		cdn["upstream_lifecycle_environment_label"] = org.CDNConfiguration.UpstreamLifecycleEnvironmentLabel
+		cdn["upstream_organization_label"] = org.CDNConfiguration.UpstreamOrganizationLabel
+		cdn["url"] = org.CDNConfiguration.URL
+		cdn["username"] = org.CDNConfiguration.Username
+		cdnConfiguration = append(cdnConfiguration, cdn)
+	}
+
+	d.Set("cdn_configuration", cdnConfiguration)
+	setTaxonomyAssociationIDs(d, "compute_resource_ids", org.ComputeResources)
 	d.Set("description", org.Description)
+	setTaxonomyAssociationIDs(d, "domain_ids", org.Domains)
+	setTaxonomyAssociationIDs(d, "hostgroup_ids", org.Hostgroups)
 	d.Set("hosts_count", org.HostsCount)
 	d.Set("label", org.Label)
+	setTaxonomyAssociationIDs(d, "location_ids", org.Locations)
+	setTaxonomyAssociationIDs(d, "medium_ids", org.Media)
 	d.Set("name", org.Name)
+	setTaxonomyAssociationIDs(d, "provisioning_template_ids", org.ProvisioningTemplates)
+	d.Set("simple_content_access", org.SimpleContentAccess)
+	setTaxonomyAssociationIDs(d, "smart_proxy_ids", org.SmartProxies)
+	setTaxonomyAssociationIDs(d, "subnet_ids", org.Subnets)
 	d.Set("title", org.Title)
+	setTaxonomyAssociationIDs(d, "user_ids", org.Users)
 
 	return nil
 }
@@ -87,6 +190,21 @@ func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, met
 	createBody := new(gosatellite.OrganizationCreate)
 	createBody.Organization.Name = d.Get("name").(string)
 
+	if !d.GetRawConfig().GetAttr("simple_content_access").IsNull() {
+		simpleContentAccess := d.Get("simple_content_access").(bool)
+		createBody.Organization.SimpleContentAccess = &simpleContentAccess
+	}
+
+	createBody.Organization.ComputeResourceIDs = taxonomyAssociationIDs(d, "compute_resource_ids")
+	createBody.Organization.DomainIDs = taxonomyAssociationIDs(d, "domain_ids")
+	createBody.Organization.HostgroupIDs = taxonomyAssociationIDs(d, "hostgroup_ids")
+	createBody.Organization.LocationIDs = taxonomyAssociationIDs(d, "location_ids")
+	createBody.Organization.MediumIDs = taxonomyAssociationIDs(d, "medium_ids")
+	createBody.Organization.ProvisioningTemplateIDs = taxonomyAssociationIDs(d, "provisioning_template_ids")
+	createBody.Organization.SmartProxyIDs = taxonomyAssociationIDs(d, "smart_proxy_ids")
+	createBody.Organization.SubnetIDs = taxonomyAssociationIDs(d, "subnet_ids")
+	createBody.Organization.UserIDs = taxonomyAssociationIDs(d, "user_ids")
+
 	org, _, err := client.Organizations.Create(context.Background(), *createBody)
 	if err != nil {
 		return diag.FromErr(err)
@@ -94,6 +212,13 @@ func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(strconv.Itoa(*org.ID))
 
+	if _, ok := d.GetOk("cdn_configuration"); ok {
+		_, _, err = client.Organizations.UpdateCDNConfiguration(context.Background(), *org.ID, organizationCDNConfiguration(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceOrganizationRead(ctx, d, meta)
 }
 
@@ -114,12 +239,33 @@ func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, met
 		name := d.Get("name").(string)
 		updateBody.Organization.Name = &name
 	}
+	if d.HasChange("simple_content_access") {
+		simpleContentAccess := d.Get("simple_content_access").(bool)
+		updateBody.Organization.SimpleContentAccess = &simpleContentAccess
+	}
+
+	updateBody.Organization.ComputeResourceIDs = taxonomyAssociationIDs(d, "compute_resource_ids")
+	updateBody.Organization.DomainIDs = taxonomyAssociationIDs(d, "domain_ids")
+	updateBody.Organization.HostgroupIDs = taxonomyAssociationIDs(d, "hostgroup_ids")
+	updateBody.Organization.LocationIDs = taxonomyAssociationIDs(d, "location_ids")
+	updateBody.Organization.MediumIDs = taxonomyAssociationIDs(d, "medium_ids")
+	updateBody.Organization.ProvisioningTemplateIDs = taxonomyAssociationIDs(d, "provisioning_template_ids")
+	updateBody.Organization.SmartProxyIDs = taxonomyAssociationIDs(d, "smart_proxy_ids")
+	updateBody.Organization.SubnetIDs = taxonomyAssociationIDs(d, "subnet_ids")
+	updateBody.Organization.UserIDs = taxonomyAssociationIDs(d, "user_ids")
 
 	_, _, err = client.Organizations.Update(context.Background(), orgID, *updateBody)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if _, ok := d.GetOk("cdn_configuration"); ok && d.HasChange("cdn_configuration") {
+		_, _, err = client.Organizations.UpdateCDNConfiguration(context.Background(), orgID, organizationCDNConfiguration(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceOrganizationRead(ctx, d, meta)
 }
 
@@ -140,3 +286,59 @@ func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return nil
 }
+
+// organizationCDNConfiguration returns the configured cdn_configuration of the organization.
+func organizationCDNConfiguration(d *schema.ResourceData) gosatellite.OrganizationCDNConfigurationUpdate {
+	cdn := d.Get("cdn_configuration").([]interface{})[0].(map[string]interface{})
+
+	cdnType := cdn["type"].(string)
+	configuration := gosatellite.OrganizationCDNConfigurationUpdate{
+		Type: &cdnType,
+	}
+
+	if password := organizationCDNPassword(d); password != "" {
+		configuration.Password = &password
+	}
+
+	if credentialID := cdn["ssl_ca_credential_id"].(int); credentialID != 0 {
+		configuration.SSLCACredentialID = &credentialID
+	}
+
+	if cvLabel := cdn["upstream_content_view_label"].(string); cvLabel != "" {
+		configuration.UpstreamContentViewLabel = &cvLabel
+	}
+
+	if leLabel := cdn["upstream_lifecycle_environment_label"].(string); leLabel != "" {
+		configuration.UpstreamLifecycleEnvironmentLabel = &leLabel
+	}
+
+	if orgLabel := cdn["upstream_organization_label"].(string); orgLabel != "" {
+		configuration.UpstreamOrganizationLabel = &orgLabel
+	}
+
+	if url := cdn["url"].(string); url != "" {
+		configuration.URL = &url
+	}
+
+	if username := cdn["username"].(string); username != "" {
+		configuration.Username = &username
+	}
+
+	return configuration
+}
+
+// organizationCDNPassword returns the write-only password of cdn_configuration from the
+// configuration.
+func organizationCDNPassword(d *schema.ResourceData) string {
+	cdn := d.GetRawConfig().GetAttr("cdn_configuration")
+	if cdn.IsNull() || !cdn.IsKnown() || cdn.LengthInt() == 0 {
+		return ""
+	}
+
+	password := cdn.AsValueSlice()[0].GetAttr("password")
+	if password.IsNull() || !password.IsKnown() {
+		return ""
+	}
+
+	return password.AsString()
+}
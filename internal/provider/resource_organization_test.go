@@ -30,3 +30,47 @@ resource "scaffolding_resource" "foo" {
   sample_attribute = "bar"
 }
 `
+
+func TestOrganizationCDNConfiguration(t *testing.T) {
+	state := map[string]string{
+		"name":                                            "downstream",
+		"cdn_configuration.#":                             "1",
+		"cdn_configuration.0.type":                        "network_sync",
+		"cdn_configuration.0.url":                         "https://satellite-upstream.example.com",
+		"cdn_configuration.0.username":                    "sync-user",
+		"cdn_configuration.0.password_version":            "1",
+		"cdn_configuration.0.upstream_organization_label": "Default_Organization",
+	}
+
+	t.Run("network sync", func(t *testing.T) {
+		d := testResourceData(t, resourceOrganization(), state, `{"name": "downstream", "cdn_configuration": [{"type": "network_sync", "password": "secret"}]}`)
+
+		cdn := organizationCDNConfiguration(d)
+		if *cdn.Type != "network_sync" {
+			t.Fatalf("expected type network_sync, got %s", *cdn.Type)
+		}
+		if cdn.URL == nil || *cdn.URL != "https://satellite-upstream.example.com" {
+			t.Fatalf("expected the url to be sent, got %v", cdn.URL)
+		}
+		if cdn.Username == nil || *cdn.Username != "sync-user" {
+			t.Fatalf("expected the username to be sent, got %v", cdn.Username)
+		}
+		if cdn.Password == nil || *cdn.Password != "secret" {
+			t.Fatalf("expected the password from the configuration to be sent, got %v", cdn.Password)
+		}
+		if cdn.UpstreamOrganizationLabel == nil || *cdn.UpstreamOrganizationLabel != "Default_Organization" {
+			t.Fatalf("expected the upstream organization label to be sent, got %v", cdn.UpstreamOrganizationLabel)
+		}
+		if cdn.SSLCACredentialID != nil || cdn.UpstreamContentViewLabel != nil || cdn.UpstreamLifecycleEnvironmentLabel != nil {
+			t.Fatalf("expected unset arguments not to be sent")
+		}
+	})
+
+	t.Run("no password", func(t *testing.T) {
+		d := testResourceData(t, resourceOrganization(), state, `{"name": "downstream", "cdn_configuration": [{"type": "network_sync"}]}`)
+
+		if cdn := organizationCDNConfiguration(d); cdn.Password != nil {
+			t.Fatalf("expected no password to be sent, got %s", *cdn.Password)
+		}
+	})
+}
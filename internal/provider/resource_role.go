@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,6 +20,8 @@ func resourceRole() *schema.Resource {
 		UpdateContext: resourceRoleUpdate,
 		DeleteContext: resourceRoleDelete,
 
+		CustomizeDiff: resourceRoleCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -51,6 +54,26 @@ func resourceRole() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
+			"clone_from_role_id": {
+				Description:   "The ID of an existing role, such as a builtin role, to clone when creating the role. The filters of the source role are copied to the new role. Once set, it cannot be changed without recreating the role.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"clone_from_role_name"},
+			},
+			"clone_from_role_name": {
+				Description:   "The name of an existing role, such as `Viewer` or `Site manager`, to clone when creating the role. The filters of the source role are copied to the new role. Once set, it cannot be changed without recreating the role.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"clone_from_role_id"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+			"filters_override": {
+				Description: "Only used when the role is cloned with `clone_from_role_id` or `clone_from_role_name`. When set to `true`, every filter of the role overrides its taxonomies with the `location_ids` and `organization_ids` of the role. When `false`, every filter of the role inherits the taxonomies of the role.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"builtin": {
 				Description: "A boolean that indicates if the role is a default/builtin role.",
 				Type:        schema.TypeInt,
@@ -132,6 +155,14 @@ func resourceRole() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"unsynced_filter_ids": {
+				Description: "A list of IDs of the filters of a cloned role whose taxonomies do not match `filters_override`. These filters are updated on the next apply.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 		},
 	}
 }
@@ -196,6 +227,15 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("organizations", organizationsList)
 	d.Set("origin", role.Origin)
 
+	unsyncedFilterIDs := []int{}
+	if roleIsCloned(d) {
+		unsyncedFilterIDs, err = roleUnsyncedFilterIDs(client, role, d.Get("filters_override").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.Set("unsynced_filter_ids", unsyncedFilterIDs)
+
 	return nil
 }
 
@@ -232,13 +272,35 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		createBody.Role.OrganizationIDs = &organizationIDs
 	}
 
-	role, _, err := client.Roles.Create(context.Background(), *createBody)
+	if !roleIsCloned(d) {
+		role, _, err := client.Roles.Create(context.Background(), *createBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.Itoa(*role.ID))
+
+		return resourceRoleRead(ctx, d, meta)
+	}
+
+	sourceRoleID, err := roleCloneSourceID(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloneBody := gosatellite.RoleClone(*createBody)
+	role, _, err := client.Roles.Clone(context.Background(), sourceRoleID, cloneBody)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(*role.ID))
 
+	err = roleSyncFilters(client, *role.ID, d.Get("filters_override").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRoleRead(ctx, d, meta)
 }
 
@@ -276,9 +338,18 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		updateBody.Role.OrganizationIDs = &organizationIDs
 	}
 
-	_, _, err = client.Roles.Update(context.Background(), roleID, *updateBody)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChanges("name", "description", "location_ids", "organization_ids") {
+		_, _, err = client.Roles.Update(context.Background(), roleID, *updateBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if roleIsCloned(d) && d.HasChanges("filters_override", "location_ids", "organization_ids", "unsynced_filter_ids") {
+		err = roleSyncFilters(client, roleID, d.Get("filters_override").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceRoleRead(ctx, d, meta)
@@ -301,3 +372,198 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 
 	return nil
 }
+
+func resourceRoleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// filters that no longer match filters_override are updated on apply
+	if d.Id() != "" && d.Get("unsynced_filter_ids").(*schema.Set).Len() > 0 {
+		return d.SetNewComputed("unsynced_filter_ids")
+	}
+
+	return nil
+}
+
+func roleIsCloned(d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("clone_from_role_id"); ok {
+		return true
+	}
+	if _, ok := d.GetOk("clone_from_role_name"); ok {
+		return true
+	}
+	return false
+}
+
+// roleCloneSourceID returns the ID of the role to clone, looking it up by name
+// if clone_from_role_name was used.
+func roleCloneSourceID(client gosatellite.Client, d *schema.ResourceData) (int, error) {
+	if id, ok := d.GetOk("clone_from_role_id"); ok {
+		return id.(int), nil
+	}
+
+	name := d.Get("clone_from_role_name").(string)
+
+	opt := new(gosatellite.RolesListOptions)
+	opt.Search = fmt.Sprintf("name=\"%s\"", name)
+
+	roleList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Role, error) {
+		r, _, err := client.Roles.List(context.Background(), opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &r.ListResponse, r.Results, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(roleList) == 0 {
+		return 0, fmt.Errorf("no roles found with name %s", name)
+	}
+
+	if len(roleList) > 1 {
+		return 0, fmt.Errorf("%d roles found with name %s", len(roleList), name)
+	}
+
+	return *roleList[0].ID, nil
+}
+
+// roleSyncFilters sets every filter of a role to either override or inherit the
+// taxonomies of the role.
+func roleSyncFilters(client gosatellite.Client, roleID int, override bool) error {
+	role, _, err := client.Roles.Get(context.Background(), roleID)
+	if err != nil {
+		return err
+	}
+
+	locationIDs, organizationIDs := roleTaxonomyIDs(role)
+
+	filters, err := roleFilters(client, roleID)
+	if err != nil {
+		return err
+	}
+
+	for _, filter := range filters {
+		if filterTaxonomiesInSync(&filter, override, locationIDs, organizationIDs) {
+			continue
+		}
+
+		updateBody := new(gosatellite.FilterUpdate)
+		updateBody.Filter.Override = &override
+		if override {
+			updateBody.Filter.LocationIDs = &locationIDs
+			if filter.ResourceType == nil || *filter.ResourceType != "Location" {
+				updateBody.Filter.OrganizationIDs = &organizationIDs
+			}
+		}
+
+		_, _, err = client.Filters.Update(context.Background(), *filter.ID, *updateBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// roleUnsyncedFilterIDs returns the IDs of the filters of a role that do not match
+// the requested override setting.
+func roleUnsyncedFilterIDs(client gosatellite.Client, role *gosatellite.Role, override bool) ([]int, error) {
+	locationIDs, organizationIDs := roleTaxonomyIDs(role)
+
+	filters, err := roleFilters(client, *role.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	filterIDs := []int{}
+	for _, filter := range filters {
+		if !filterTaxonomiesInSync(&filter, override, locationIDs, organizationIDs) {
+			filterIDs = append(filterIDs, *filter.ID)
+		}
+	}
+
+	return filterIDs, nil
+}
+
+// roleFilters returns every filter of a role with its taxonomies.
+func roleFilters(client gosatellite.Client, roleID int) ([]gosatellite.Filter, error) {
+	opt := new(gosatellite.FiltersListOptions)
+	opt.Search = fmt.Sprintf("role_id=%d", roleID)
+
+	return listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.Filter, error) {
+		f, _, err := client.Filters.List(context.Background(), *opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &f.ListResponse, f.Results, nil
+	})
+}
+
+func roleTaxonomyIDs(role *gosatellite.Role) ([]int, []int) {
+	locationIDs := []int{}
+	for _, x := range *role.Locations {
+		locationIDs = append(locationIDs, *x.ID)
+	}
+
+	organizationIDs := []int{}
+	for _, x := range *role.Organizations {
+		organizationIDs = append(organizationIDs, *x.ID)
+	}
+
+	return locationIDs, organizationIDs
+}
+
+func filterTaxonomiesInSync(filter *gosatellite.Filter, override bool, locationIDs []int, organizationIDs []int) bool {
+	filterOverride := filter.Override != nil && *filter.Override
+	if filterOverride != override {
+		return false
+	}
+
+	// filters that inherit their taxonomies are kept in sync by Satellite
+	if !override {
+		return true
+	}
+
+	filterLocationIDs := []int{}
+	for _, x := range *filter.Locations {
+		filterLocationIDs = append(filterLocationIDs, *x.ID)
+	}
+	if !sameIntSet(filterLocationIDs, locationIDs) {
+		return false
+	}
+
+	if filter.ResourceType != nil && *filter.ResourceType == "Location" {
+		return true
+	}
+
+	filterOrganizationIDs := []int{}
+	for _, x := range *filter.Organizations {
+		filterOrganizationIDs = append(filterOrganizationIDs, *x.ID)
+	}
+
+	return sameIntSet(filterOrganizationIDs, organizationIDs)
+}
+
+// sameIntSet reports whether a and b contain the same IDs, ignoring order and
+// duplicates.
+func sameIntSet(a []int, b []int) bool {
+	membersA := make(map[int]bool)
+	for _, x := range a {
+		membersA[x] = true
+	}
+
+	membersB := make(map[int]bool)
+	for _, x := range b {
+		membersB[x] = true
+	}
+
+	if len(membersA) != len(membersB) {
+		return false
+	}
+	for x := range membersA {
+		if !membersB[x] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,451 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/umich-vci/gosatellite"
+)
+
+func resourceRoleFilters() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource to authoritatively manage the complete set of permission filters for a role in Red Hat Satellite. Any filter on the role that is not declared in this resource will be removed. This resource should not be used together with `satellite_filter` resources for the same role.",
+
+		CreateContext: resourceRoleFiltersCreate,
+		ReadContext:   resourceRoleFiltersRead,
+		UpdateContext: resourceRoleFiltersUpdate,
+		DeleteContext: resourceRoleFiltersDelete,
+
+		CustomizeDiff: resourceRoleFiltersCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Description: "The ID of the role whose filters should be managed. Once set, it cannot be changed without recreating the resource.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filter": {
+				Description: "A filter that should exist on the role. Filters that exist on the role but are not declared here are deleted.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         roleFilterHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Description: "The resource type of the filter.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"permission_names": {
+							Description: "A list of permission names that should be enabled in the filter. The permission names must be valid for the `resource_type`.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"search": {
+							Description: "A search used to limit the resources that the permissions apply to. If not set, the filter applies to all objects of the `resource_type`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"override": {
+							Description: "When set to true, the filter uses `location_ids` and `organization_ids` instead of inheriting the taxonomies of the role.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"location_ids": {
+							Description: "A list of IDs of locations to associate with the filter. Can only be set when `override` is `true`.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"organization_ids": {
+							Description: "A list of IDs of organizations to associate with the filter. Can only be set when `override` is `true`.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+			"filter_ids": {
+				Description: "A list of the IDs of the filters associated with the role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceRoleFiltersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	roleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, resp, err := client.Roles.Get(context.Background(), roleID)
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	filterIDs := []int{}
+	filterList := []interface{}{}
+	for _, x := range *role.Filters {
+		filter, _, err := client.Filters.Get(context.Background(), *x.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		filterIDs = append(filterIDs, *filter.ID)
+		filterList = append(filterList, flattenRoleFilter(filter))
+	}
+
+	d.Set("role_id", roleID)
+	d.Set("filter", filterList)
+	d.Set("filter_ids", filterIDs)
+
+	return nil
+}
+
+func resourceRoleFiltersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	roleID := d.Get("role_id").(int)
+
+	err := roleFiltersReconcile(client, roleID, d.Get("filter").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(roleID))
+
+	return resourceRoleFiltersRead(ctx, d, meta)
+}
+
+func resourceRoleFiltersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	roleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("filter") {
+		err = roleFiltersReconcile(client, roleID, d.Get("filter").(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRoleFiltersRead(ctx, d, meta)
+}
+
+func resourceRoleFiltersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	roleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = roleFiltersReconcile(client, roleID, []interface{}{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRoleFiltersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("filter") || !d.NewValueKnown("filter") {
+		return nil
+	}
+
+	filters := d.Get("filter").(*schema.Set).List()
+
+	for _, x := range filters {
+		filter := x.(map[string]interface{})
+		if filter["resource_type"].(string) == "Location" && filter["organization_ids"].(*schema.Set).Len() > 0 {
+			return fmt.Errorf("organization_ids cannot be specified for a resource_type of Location")
+		}
+		// filters that do not override inherit the taxonomies of the role
+		if !filter["override"].(bool) && (filter["location_ids"].(*schema.Set).Len() > 0 || filter["organization_ids"].(*schema.Set).Len() > 0) {
+			return fmt.Errorf("location_ids and organization_ids can only be specified for a filter with override set to true")
+		}
+	}
+
+	if meta == nil {
+		return roleFiltersValidateStaticResourceTypes(filters)
+	}
+
+	client := meta.(*apiClient).Client
+
+	// like satellite_filter, fall back to the built in list of resource types and let
+	// roleFiltersReconcile report the unavailable catalog when the filters are applied.
+	catalog, err := filterPermissionCatalog(client)
+	if err != nil {
+		return roleFiltersValidateStaticResourceTypes(filters)
+	}
+
+	for _, x := range filters {
+		filter := x.(map[string]interface{})
+		resourceType := filter["resource_type"].(string)
+		if _, ok := catalog[resourceType]; !ok {
+			return fmt.Errorf("%q is not a valid resource_type on this Satellite server", resourceType)
+		}
+		_, err := lookupPermissionIDs(catalog, resourceType, filter["permission_names"].(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func roleFiltersValidateStaticResourceTypes(filters []interface{}) error {
+	for _, x := range filters {
+		filter := x.(map[string]interface{})
+		if err := validateStaticResourceType(filter["resource_type"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// roleFiltersReconcile makes the filters of a role match desired. Filters that already
+// match are left alone, filters that differ are updated in place when a filter of the
+// same resource type is wanted, and the rest are created or deleted.
+func roleFiltersReconcile(client gosatellite.Client, roleID int, desired []interface{}) error {
+	role, _, err := client.Roles.Get(context.Background(), roleID)
+	if err != nil {
+		return err
+	}
+
+	existing := []*gosatellite.Filter{}
+	for _, x := range *role.Filters {
+		filter, _, err := client.Filters.Get(context.Background(), *x.ID)
+		if err != nil {
+			return err
+		}
+		existing = append(existing, filter)
+	}
+
+	unmatchedDesired := []map[string]interface{}{}
+	for _, x := range desired {
+		want := x.(map[string]interface{})
+		wantKey := roleFilterKey(want)
+
+		found := false
+		for i, filter := range existing {
+			if roleFilterKey(flattenRoleFilter(filter)) == wantKey {
+				existing = append(existing[:i], existing[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatchedDesired = append(unmatchedDesired, want)
+		}
+	}
+
+	if len(unmatchedDesired) == 0 && len(existing) == 0 {
+		return nil
+	}
+
+	catalog := map[string]map[string]int{}
+	if len(unmatchedDesired) > 0 {
+		catalog, err = filterPermissionCatalog(client)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, want := range unmatchedDesired {
+		resourceType := want["resource_type"].(string)
+		permIDs, err := lookupPermissionIDs(catalog, resourceType, want["permission_names"].(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		override := want["override"].(bool)
+		search := want["search"].(string)
+
+		locationIDs := []int{}
+		organizationIDs := []int{}
+		if override {
+			for _, x := range want["location_ids"].(*schema.Set).List() {
+				locationIDs = append(locationIDs, x.(int))
+			}
+			for _, x := range want["organization_ids"].(*schema.Set).List() {
+				organizationIDs = append(organizationIDs, x.(int))
+			}
+		}
+
+		// reuse a leftover filter of the same resource type if there is one
+		reuse := -1
+		for i, filter := range existing {
+			if flattenRoleFilter(filter)["resource_type"].(string) == resourceType {
+				reuse = i
+				break
+			}
+		}
+
+		if reuse >= 0 {
+			filterID := *existing[reuse].ID
+			existing = append(existing[:reuse], existing[reuse+1:]...)
+
+			updateBody := new(gosatellite.FilterUpdate)
+			updateBody.Filter.PermissionIDs = &permIDs
+			updateBody.Filter.Search = &search
+			updateBody.Filter.Override = &override
+			if override {
+				updateBody.Filter.LocationIDs = &locationIDs
+				if resourceType != "Location" {
+					updateBody.Filter.OrganizationIDs = &organizationIDs
+				}
+			}
+
+			_, _, err = client.Filters.Update(context.Background(), filterID, *updateBody)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		createBody := new(gosatellite.FilterCreate)
+		createBody.Filter.RoleID = &roleID
+		createBody.Filter.PermissionIDs = &permIDs
+		createBody.Filter.Override = &override
+		if search != "" {
+			createBody.Filter.Search = &search
+		}
+		if override {
+			createBody.Filter.LocationIDs = &locationIDs
+			if resourceType != "Location" {
+				createBody.Filter.OrganizationIDs = &organizationIDs
+			}
+		}
+
+		_, _, err = client.Filters.Create(context.Background(), *createBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, filter := range existing {
+		_, err = client.Filters.Delete(context.Background(), *filter.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenRoleFilter converts a filter into the same shape as a filter block in the
+// configuration. Taxonomies are only reported for filters that override them since
+// Satellite copies the taxonomies of the role to every other filter.
+func flattenRoleFilter(filter *gosatellite.Filter) map[string]interface{} {
+	resourceType := ""
+	if filter.ResourceType != nil {
+		resourceType = *filter.ResourceType
+	}
+
+	search := ""
+	if filter.Search != nil {
+		search = *filter.Search
+	}
+
+	override := filter.Override != nil && *filter.Override
+
+	permNames := []interface{}{}
+	for _, x := range *filter.Permissions {
+		permNames = append(permNames, *x.Name)
+	}
+
+	locationIDs := []interface{}{}
+	organizationIDs := []interface{}{}
+	if override {
+		for _, x := range *filter.Locations {
+			locationIDs = append(locationIDs, *x.ID)
+		}
+		for _, x := range *filter.Organizations {
+			organizationIDs = append(organizationIDs, *x.ID)
+		}
+	}
+
+	return map[string]interface{}{
+		"resource_type":    resourceType,
+		"permission_names": schema.NewSet(schema.HashString, permNames),
+		"search":           search,
+		"override":         override,
+		"location_ids":     schema.NewSet(schema.HashInt, locationIDs),
+		"organization_ids": schema.NewSet(schema.HashInt, organizationIDs),
+	}
+}
+
+// roleFilterKey builds a string that uniquely describes the contents of a filter block.
+func roleFilterKey(filter map[string]interface{}) string {
+	override := filter["override"].(bool)
+
+	permNames := []string{}
+	for _, x := range filter["permission_names"].(*schema.Set).List() {
+		permNames = append(permNames, x.(string))
+	}
+	sort.Strings(permNames)
+
+	locationIDs := []int{}
+	organizationIDs := []int{}
+	if override {
+		for _, x := range filter["location_ids"].(*schema.Set).List() {
+			locationIDs = append(locationIDs, x.(int))
+		}
+		for _, x := range filter["organization_ids"].(*schema.Set).List() {
+			organizationIDs = append(organizationIDs, x.(int))
+		}
+	}
+	sort.Ints(locationIDs)
+	sort.Ints(organizationIDs)
+
+	return fmt.Sprintf("%s|%s|%s|%t|%v|%v",
+		filter["resource_type"].(string),
+		strings.Join(permNames, ","),
+		filter["search"].(string),
+		override,
+		locationIDs,
+		organizationIDs,
+	)
+}
+
+func roleFilterHash(v interface{}) int {
+	return schema.HashString(roleFilterKey(v.(map[string]interface{})))
+}
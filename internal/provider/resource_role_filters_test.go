@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccResourceRoleFilters(t *testing.T) {
+	t.Skip("resource not yet implemented, remove this once you add your own code")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRoleFilters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"scaffolding_resource.foo", "sample_attribute", regexp.MustCompile("^ba")),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceRoleFilters = `
+resource "scaffolding_resource" "foo" {
+  sample_attribute = "bar"
+}
+`
+
+func TestRoleFilterKey(t *testing.T) {
+	filter := func(permNames []interface{}, override bool, locationIDs []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"resource_type":    "Host",
+			"permission_names": schema.NewSet(schema.HashString, permNames),
+			"search":           "hostgroup = web",
+			"override":         override,
+			"location_ids":     schema.NewSet(schema.HashInt, locationIDs),
+			"organization_ids": schema.NewSet(schema.HashInt, []interface{}{}),
+		}
+	}
+
+	cases := []struct {
+		name string
+		a    map[string]interface{}
+		b    map[string]interface{}
+		same bool
+	}{
+		{
+			name: "permission order does not matter",
+			a:    filter([]interface{}{"view_hosts", "edit_hosts"}, false, []interface{}{}),
+			b:    filter([]interface{}{"edit_hosts", "view_hosts"}, false, []interface{}{}),
+			same: true,
+		},
+		{
+			name: "different permissions",
+			a:    filter([]interface{}{"view_hosts"}, false, []interface{}{}),
+			b:    filter([]interface{}{"view_hosts", "edit_hosts"}, false, []interface{}{}),
+			same: false,
+		},
+		{
+			name: "taxonomies are ignored without override",
+			a:    filter([]interface{}{"view_hosts"}, false, []interface{}{1}),
+			b:    filter([]interface{}{"view_hosts"}, false, []interface{}{2}),
+			same: true,
+		},
+		{
+			name: "taxonomies are compared with override",
+			a:    filter([]interface{}{"view_hosts"}, true, []interface{}{1}),
+			b:    filter([]interface{}{"view_hosts"}, true, []interface{}{2}),
+			same: false,
+		},
+		{
+			name: "different override",
+			a:    filter([]interface{}{"view_hosts"}, true, []interface{}{}),
+			b:    filter([]interface{}{"view_hosts"}, false, []interface{}{}),
+			same: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a, b := roleFilterKey(c.a), roleFilterKey(c.b)
+			if (a == b) != c.same {
+				t.Fatalf("expected keys %q and %q to match: %t", a, b, c.same)
+			}
+		})
+	}
+}
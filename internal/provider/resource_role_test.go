@@ -30,3 +30,30 @@ resource "scaffolding_resource" "foo" {
   sample_attribute = "bar"
 }
 `
+
+func TestSameIntSet(t *testing.T) {
+	cases := []struct {
+		name string
+		a    []int
+		b    []int
+		same bool
+	}{
+		{"both empty", []int{}, []int{}, true},
+		{"same order", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different order", []int{3, 1, 2}, []int{1, 2, 3}, true},
+		{"different length", []int{1, 2}, []int{1, 2, 3}, false},
+		{"different members", []int{1, 2, 4}, []int{1, 2, 3}, false},
+		{"one empty", []int{}, []int{1}, false},
+		{"duplicates in one", []int{1, 1, 2}, []int{1, 2}, true},
+		{"duplicates hide a missing member", []int{1, 1}, []int{1, 2}, false},
+		{"duplicates on both sides", []int{1, 1, 2}, []int{1, 2, 2}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if same := sameIntSet(c.a, c.b); same != c.same {
+				t.Fatalf("sameIntSet(%v, %v) = %t, expected %t", c.a, c.b, same, c.same)
+			}
+		})
+	}
+}
@@ -2,11 +2,18 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
 )
 
 func resourceSubscriptionManifest() *schema.Resource {
@@ -18,10 +25,21 @@ func resourceSubscriptionManifest() *schema.Resource {
 		UpdateContext: resourceSubscriptionManifestUpdate,
 		DeleteContext: resourceSubscriptionManifestDelete,
 
+		CustomizeDiff: resourceSubscriptionManifestCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceSubscriptionManifestV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSubscriptionManifestStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
 				Description: "The organization ID you want to attach the manifest to.",
@@ -30,10 +48,41 @@ func resourceSubscriptionManifest() *schema.Resource {
 				ForceNew:    true,
 			},
 			"manifest": {
-				Description: "A Base64 encoded string of a manifest zip file downloaded from Red Hat Subscription Management. Most easily used in conjunction with [`rhsm_allocation_manifest` resource from the RHSM provider](https://registry.terraform.io/providers/umich-vci/rhsm/latest/docs/resources/allocation_manifest).",
+				Description:  "A Base64 encoded string of a manifest zip file downloaded from Red Hat Subscription Management. Most easily used in conjunction with [`rhsm_allocation_manifest` resource from the RHSM provider](https://registry.terraform.io/providers/umich-vci/rhsm/latest/docs/resources/allocation_manifest). Only the SHA-256 of the manifest is stored in the state. Exactly one of `manifest` or `manifest_path` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"manifest", "manifest_path"},
+				ValidateFunc: validation.StringIsBase64,
+				StateFunc:    subscriptionManifestStateFunc,
+			},
+			"manifest_path": {
+				Description:  "The path to a manifest zip file downloaded from Red Hat Subscription Management. The manifest is uploaded again when the content of the file changes. If the file does not exist when planning, such as when it is created by another resource, it is read when applying instead. Exactly one of `manifest` or `manifest_path` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"manifest", "manifest_path"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"manifest_sha256": {
+				Description: "The SHA-256 of the content of the manifest zip file. This is computed from `manifest` or `manifest_path` if it is not set. If it is set, such as to `filesha256(\"manifest.zip\")`, it must match the content of the manifest.",
 				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Optional:    true,
+				Computed:    true,
+			},
+			"refresh_trigger": {
+				Description: "An arbitrary string that causes the manifest to be refreshed from Red Hat Subscription Management when it changes. The manifest is not refreshed when a new manifest is uploaded in the same apply.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"consumer_name": {
+				Description: "The name of the subscription allocation the manifest was exported from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expiration_date": {
+				Description: "Timestamp of when the manifest expires.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 			"history": {
 				Description: "A list of objects containing information on operations peformed on the manifest.",
@@ -64,6 +113,11 @@ func resourceSubscriptionManifest() *schema.Resource {
 					},
 				},
 			},
+			"uuid": {
+				Description: "The UUID of the subscription allocation the manifest was exported from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -98,8 +152,22 @@ func resourceSubscriptionManifestRead(ctx context.Context, d *schema.ResourceDat
 		histList = append(histList, histItem)
 	}
 
+	manifest, resp, err := client.Manifests.Get(context.Background(), orgID)
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
 	d.Set("organization_id", orgID)
+	d.Set("consumer_name", manifest.ConsumerName)
+	d.Set("expiration_date", manifest.ExpirationDate)
 	d.Set("history", histList)
+	d.Set("uuid", manifest.UUID)
 
 	return nil
 }
@@ -109,13 +177,7 @@ func resourceSubscriptionManifestCreate(ctx context.Context, d *schema.ResourceD
 
 	orgID := d.Get("organization_id").(int)
 
-	manifestString := d.Get("manifest").(string)
-	manifest, err := base64.StdEncoding.DecodeString(manifestString)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	_, _, err = client.Manifests.Upload(context.Background(), orgID, nil, manifest, "manifest.zip")
+	err := subscriptionManifestUpload(client, orgID, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -133,9 +195,18 @@ func resourceSubscriptionManifestUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	_, err = client.Manifests.Refresh(context.Background(), orgID)
-	if err != nil {
-		return diag.FromErr(err)
+	// a newly uploaded manifest is already current, so it is only refreshed
+	// when refresh_trigger changes without the content of the manifest changing
+	if d.HasChange("manifest_sha256") {
+		err = subscriptionManifestUpload(client, orgID, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("refresh_trigger") {
+		_, err = client.Manifests.Refresh(context.Background(), orgID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceSubscriptionManifestRead(ctx, d, meta)
@@ -158,3 +229,175 @@ func resourceSubscriptionManifestDelete(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+func resourceSubscriptionManifestCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	manifest := config.GetAttr("manifest")
+	manifestPath := config.GetAttr("manifest_path")
+
+	// the content of the manifest is not known until apply
+	if !manifest.IsWhollyKnown() || !manifestPath.IsWhollyKnown() {
+		return d.SetNewComputed("manifest_sha256")
+	}
+
+	manifestString := ""
+	if !manifest.IsNull() {
+		manifestString = manifest.AsString()
+	}
+
+	path := ""
+	if !manifestPath.IsNull() {
+		path = manifestPath.AsString()
+	}
+
+	content, err := subscriptionManifestContent(manifestString, path)
+	if err != nil {
+		// the file may be created by another resource during apply, in which case it
+		// is read and uploaded then
+		if os.IsNotExist(err) {
+			keys := []string{"consumer_name", "expiration_date", "uuid"}
+			if config.GetAttr("manifest_sha256").IsNull() {
+				keys = append(keys, "manifest_sha256")
+			}
+			for _, key := range keys {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return err
+	}
+
+	sum := subscriptionManifestSHA256(content)
+
+	configSum := config.GetAttr("manifest_sha256")
+	if configSum.IsKnown() && !configSum.IsNull() && configSum.AsString() != sum {
+		return fmt.Errorf("manifest_sha256 %s does not match the SHA-256 of the manifest, %s", configSum.AsString(), sum)
+	}
+
+	if d.Get("manifest_sha256").(string) != sum {
+		if err := d.SetNew("manifest_sha256", sum); err != nil {
+			return err
+		}
+
+		// a new manifest may come from a different subscription allocation
+		for _, key := range []string{"consumer_name", "expiration_date", "uuid"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	} else if d.Id() != "" && d.HasChange("refresh_trigger") {
+		if err := d.SetNewComputed("expiration_date"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// subscriptionManifestUpload uploads the manifest configured by manifest or manifest_path
+// to the organization and records the SHA-256 of the uploaded manifest in manifest_sha256.
+func subscriptionManifestUpload(client gosatellite.Client, orgID int, d *schema.ResourceData) error {
+	path := d.Get("manifest_path").(string)
+
+	content, err := subscriptionManifestContent(d.Get("manifest").(string), path)
+	if err != nil {
+		return err
+	}
+
+	filename := "manifest.zip"
+	if path != "" {
+		filename = filepath.Base(path)
+	}
+
+	_, _, err = client.Manifests.Upload(context.Background(), orgID, nil, content, filename)
+	if err != nil {
+		return err
+	}
+
+	// the plan leaves manifest_sha256 unknown when the manifest file did not exist yet
+	d.Set("manifest_sha256", subscriptionManifestSHA256(content))
+
+	return nil
+}
+
+// subscriptionManifestContent returns the content of the manifest, either decoded from the
+// Base64 encoded manifest or read from the file at path.
+func subscriptionManifestContent(manifest string, path string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+
+	return base64.StdEncoding.DecodeString(manifest)
+}
+
+func subscriptionManifestSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// subscriptionManifestStateFunc stores the SHA-256 of the manifest in the state in place
+// of the manifest itself. A manifest that is not valid Base64 is hashed as is so that
+// it is still never stored in the state.
+func subscriptionManifestStateFunc(v interface{}) string {
+	content, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return subscriptionManifestSHA256([]byte(v.(string)))
+	}
+
+	return subscriptionManifestSHA256(content)
+}
+
+func resourceSubscriptionManifestV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"manifest": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"history": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceSubscriptionManifestStateUpgradeV0 replaces the manifest stored in the state
+// by version 0 of the schema with its SHA-256.
+func resourceSubscriptionManifestStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if manifest, ok := rawState["manifest"].(string); ok && manifest != "" {
+		sum := subscriptionManifestStateFunc(manifest)
+		rawState["manifest"] = sum
+		rawState["manifest_sha256"] = sum
+	}
+
+	return rawState, nil
+}
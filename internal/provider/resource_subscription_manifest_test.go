@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/umich-vci/gosatellite"
 )
 
 func TestAccResourceSubscriptionManifest(t *testing.T) {
@@ -30,3 +35,55 @@ resource "scaffolding_resource" "foo" {
   sample_attribute = "bar"
 }
 `
+
+type testManifestsService struct {
+	gosatellite.ManifestsService
+	uploaded []byte
+	err      error
+}
+
+func (s *testManifestsService) Upload(ctx context.Context, orgID int, repositoryURL *string, content []byte, filename string) (*gosatellite.ManifestTask, *gosatellite.Response, error) {
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	s.uploaded = content
+	return &gosatellite.ManifestTask{}, nil, nil
+}
+
+func TestSubscriptionManifestUpload(t *testing.T) {
+	content := []byte("manifest content")
+	path := filepath.Join(t.TempDir(), "manifest.zip")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state := map[string]string{"organization_id": "1", "manifest_path": path}
+	config := `{"organization_id": 1, "manifest_path": "` + path + `"}`
+
+	t.Run("uploaded", func(t *testing.T) {
+		manifests := &testManifestsService{}
+		d := testResourceData(t, resourceSubscriptionManifest(), state, config)
+
+		if err := subscriptionManifestUpload(gosatellite.Client{Manifests: manifests}, 1, d); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if string(manifests.uploaded) != string(content) {
+			t.Fatalf("expected the manifest to be uploaded, got %q", manifests.uploaded)
+		}
+		if sum := d.Get("manifest_sha256").(string); sum != subscriptionManifestSHA256(content) {
+			t.Fatalf("expected manifest_sha256 to be the SHA-256 of the uploaded manifest, got %q", sum)
+		}
+	})
+
+	t.Run("upload failed", func(t *testing.T) {
+		manifests := &testManifestsService{err: errors.New("upload failed")}
+		d := testResourceData(t, resourceSubscriptionManifest(), state, config)
+
+		if err := subscriptionManifestUpload(gosatellite.Client{Manifests: manifests}, 1, d); err == nil {
+			t.Fatalf("expected an error")
+		}
+		if sum := d.Get("manifest_sha256").(string); sum != "" {
+			t.Fatalf("expected manifest_sha256 not to be set, got %q", sum)
+		}
+	})
+}
@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/umich-vci/gosatellite"
+)
+
+// Satellite implicitly grants this role to every user, so it is never managed with role_ids.
+const defaultRoleName = "Default role"
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource to manage a user in Red Hat Satellite.",
+
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"login": {
+				Description:  "The login name of the user.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"auth_source_id": {
+				Description: "The ID of the authentication source of the user. Use the ID of the internal authentication source for local accounts, or the ID of an LDAP authentication source for directory accounts.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"admin": {
+				Description: "If set to true, then the user will have administrator privileges.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"default_location_id": {
+				Description: "The ID of the location the user uses by default.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"default_organization_id": {
+				Description: "The ID of the organization the user uses by default.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"description": {
+				Description: "A description of the user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"firstname": {
+				Description: "The first name of the user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"lastname": {
+				Description: "The last name of the user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location_ids": {
+				Description: "A list of IDs of locations the user is a member of.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"mail": {
+				Description: "The email address of the user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"organization_ids": {
+				Description: "A list of IDs of organizations the user is a member of.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"password": {
+				Description: "The password of the user. Only used for users of the internal authentication source. This is a write-only argument that requires Terraform 1.11 or later. It is never stored in the state, so it is only sent when the user is created or `password_version` changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_version": {
+				Description: "An arbitrary number that is changed to send `password` to Satellite again, rotating the password of the user.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"role_ids": {
+				Description: "A list of IDs of roles to associate with the user.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"auth_source_name": {
+				Description: "The name of the authentication source of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "A timestamp containing when the user was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_login_on": {
+				Description: "A timestamp containing when the user last logged in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "A timestamp containing when the user was last changed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	userID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, resp, err := client.Users.Get(context.Background(), userID)
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	locationIDs := []int{}
+	for _, x := range *user.Locations {
+		locationIDs = append(locationIDs, *x.ID)
+	}
+
+	organizationIDs := []int{}
+	for _, x := range *user.Organizations {
+		organizationIDs = append(organizationIDs, *x.ID)
+	}
+
+	roleIDs := []int{}
+	for _, x := range *user.Roles {
+		if x.Name != nil && *x.Name == defaultRoleName {
+			continue
+		}
+		roleIDs = append(roleIDs, *x.ID)
+	}
+
+	d.Set("login", user.Login)
+	d.Set("auth_source_id", user.AuthSourceID)
+	d.Set("auth_source_name", user.AuthSourceName)
+	d.Set("admin", user.Admin)
+	d.Set("description", user.Description)
+	d.Set("firstname", user.FirstName)
+	d.Set("lastname", user.LastName)
+	d.Set("mail", user.Mail)
+	d.Set("location_ids", locationIDs)
+	d.Set("organization_ids", organizationIDs)
+	d.Set("role_ids", roleIDs)
+	d.Set("created_at", user.CreatedAt)
+	d.Set("last_login_on", user.LastLoginOn)
+	d.Set("updated_at", user.UpdatedAt)
+
+	if user.DefaultLocation != nil {
+		d.Set("default_location_id", user.DefaultLocation.ID)
+	} else {
+		d.Set("default_location_id", nil)
+	}
+
+	if user.DefaultOrganization != nil {
+		d.Set("default_organization_id", user.DefaultOrganization.ID)
+	} else {
+		d.Set("default_organization_id", nil)
+	}
+
+	return nil
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	login := d.Get("login").(string)
+	asID := d.Get("auth_source_id").(int)
+
+	createBody := new(gosatellite.UserCreate)
+	createBody.User.Login = &login
+	createBody.User.AuthSourceID = &asID
+
+	if adm, ok := d.GetOk("admin"); ok {
+		admin := adm.(bool)
+		createBody.User.Admin = &admin
+	}
+
+	if dl, ok := d.GetOk("default_location_id"); ok {
+		defaultLocationID := dl.(int)
+		createBody.User.DefaultLocationID = &defaultLocationID
+	}
+
+	if do, ok := d.GetOk("default_organization_id"); ok {
+		defaultOrganizationID := do.(int)
+		createBody.User.DefaultOrganizationID = &defaultOrganizationID
+	}
+
+	if desc, ok := d.GetOk("description"); ok {
+		description := desc.(string)
+		createBody.User.Description = &description
+	}
+
+	if fn, ok := d.GetOk("firstname"); ok {
+		firstName := fn.(string)
+		createBody.User.FirstName = &firstName
+	}
+
+	if ln, ok := d.GetOk("lastname"); ok {
+		lastName := ln.(string)
+		createBody.User.LastName = &lastName
+	}
+
+	if m, ok := d.GetOk("mail"); ok {
+		mail := m.(string)
+		createBody.User.Mail = &mail
+	}
+
+	if password, ok := userPassword(d); ok {
+		createBody.User.Password = &password
+	}
+
+	if loc, ok := d.GetOk("location_ids"); ok {
+		rawLocationIDs := loc.(*schema.Set).List()
+		locationIDs := []int{}
+		for x := range rawLocationIDs {
+			locationIDs = append(locationIDs, rawLocationIDs[x].(int))
+		}
+		createBody.User.LocationIDs = &locationIDs
+	}
+
+	if org, ok := d.GetOk("organization_ids"); ok {
+		rawOrganizationIDs := org.(*schema.Set).List()
+		organizationIDs := []int{}
+		for x := range rawOrganizationIDs {
+			organizationIDs = append(organizationIDs, rawOrganizationIDs[x].(int))
+		}
+		createBody.User.OrganizationIDs = &organizationIDs
+	}
+
+	if ri, ok := d.GetOk("role_ids"); ok {
+		rawRoleIDs := ri.(*schema.Set).List()
+		roleIDs := []int{}
+		for x := range rawRoleIDs {
+			roleIDs = append(roleIDs, rawRoleIDs[x].(int))
+		}
+		createBody.User.RoleIDs = &roleIDs
+	}
+
+	user, _, err := client.Users.Create(context.Background(), *createBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(*user.ID))
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	userID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateBody := new(gosatellite.UserUpdate)
+
+	if d.HasChange("login") {
+		login := d.Get("login").(string)
+		updateBody.User.Login = &login
+	}
+	if d.HasChange("auth_source_id") {
+		asID := d.Get("auth_source_id").(int)
+		updateBody.User.AuthSourceID = &asID
+	}
+	if d.HasChange("admin") {
+		admin := d.Get("admin").(bool)
+		updateBody.User.Admin = &admin
+	}
+	// cleared defaults are sent as null below instead of 0
+	if d.HasChange("default_location_id") {
+		if defaultLocationID := d.Get("default_location_id").(int); defaultLocationID != 0 {
+			updateBody.User.DefaultLocationID = &defaultLocationID
+		}
+	}
+	if d.HasChange("default_organization_id") {
+		if defaultOrganizationID := d.Get("default_organization_id").(int); defaultOrganizationID != 0 {
+			updateBody.User.DefaultOrganizationID = &defaultOrganizationID
+		}
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateBody.User.Description = &description
+	}
+	if d.HasChange("firstname") {
+		firstName := d.Get("firstname").(string)
+		updateBody.User.FirstName = &firstName
+	}
+	if d.HasChange("lastname") {
+		lastName := d.Get("lastname").(string)
+		updateBody.User.LastName = &lastName
+	}
+	if d.HasChange("mail") {
+		mail := d.Get("mail").(string)
+		updateBody.User.Mail = &mail
+	}
+	if d.HasChange("password_version") {
+		if password, ok := userPassword(d); ok {
+			updateBody.User.Password = &password
+		}
+	}
+	if d.HasChange("location_ids") {
+		rawLocationIDs := d.Get("location_ids").(*schema.Set).List()
+		locationIDs := []int{}
+		for x := range rawLocationIDs {
+			locationIDs = append(locationIDs, rawLocationIDs[x].(int))
+		}
+		updateBody.User.LocationIDs = &locationIDs
+	}
+	if d.HasChange("organization_ids") {
+		rawOrganizationIDs := d.Get("organization_ids").(*schema.Set).List()
+		organizationIDs := []int{}
+		for x := range rawOrganizationIDs {
+			organizationIDs = append(organizationIDs, rawOrganizationIDs[x].(int))
+		}
+		updateBody.User.OrganizationIDs = &organizationIDs
+	}
+	if d.HasChange("role_ids") {
+		rawRoleIDs := d.Get("role_ids").(*schema.Set).List()
+		roleIDs := []int{}
+		for x := range rawRoleIDs {
+			roleIDs = append(roleIDs, rawRoleIDs[x].(int))
+		}
+		updateBody.User.RoleIDs = &roleIDs
+	}
+
+	_, _, err = client.Users.Update(context.Background(), userID, *updateBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cleared := clearedIDAttributes(d, "default_location_id", "default_organization_id")
+	err = setAttributesNull(client, fmt.Sprintf("api/users/%d", userID), "user", cleared)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*apiClient).Client
+
+	userID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Users.Delete(context.Background(), userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// userPassword returns the write-only password from the configuration.
+func userPassword(d *schema.ResourceData) (string, bool) {
+	password := d.GetRawConfig().GetAttr("password")
+	if password.IsNull() || !password.IsKnown() {
+		return "", false
+	}
+
+	return password.AsString(), true
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -35,20 +36,32 @@ func resourceUserGroup() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
-			// "user_ids": {
-			// 	Type:     schema.TypeSet,
-			// 	Optional: true,
-			// 	Elem: &schema.Schema{
-			// 		Type: schema.TypeInt,
-			// 	},
-			// },
-			// "usergroup_ids": {
-			// 	Type:     schema.TypeSet,
-			// 	Optional: true,
-			// 	Elem: &schema.Schema{
-			// 		Type: schema.TypeInt,
-			// 	},
-			// },
+			"user_ids": {
+				Description:   "A list of IDs of users that are members of the group. Conflicts with `user_logins`. Members added outside of Terraform, such as by an external user group, will be removed, and an empty list removes every member. If neither `user_ids` nor `user_logins` is set, the members are left as they are in Satellite.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"user_logins"},
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"user_logins": {
+				Description:   "A list of logins of users that are members of the group. Conflicts with `user_ids`. Members added outside of Terraform, such as by an external user group, will be removed, and an empty list removes every member. If neither `user_ids` nor `user_logins` is set, the members are left as they are in Satellite.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"user_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"usergroup_ids": {
+				Description: "A list of IDs of user groups that are nested in the group. If not set, the nested groups are left as they are in Satellite.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 			"role_ids": {
 				Description: "A list of IDs of roles to associate with the group.",
 				Type:        schema.TypeSet,
@@ -131,9 +144,31 @@ func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		roleList = append(roleList, role)
 	}
 
+	userIDs := []int{}
+	userLogins := []string{}
+	for _, x := range *ug.Users {
+		userIDs = append(userIDs, *x.ID)
+		userLogins = append(userLogins, *x.Login)
+	}
+
+	userGroupIDs := []int{}
+	for _, x := range *ug.UserGroups {
+		userGroupIDs = append(userGroupIDs, *x.ID)
+	}
+
 	d.Set("name", ug.Name)
 	d.Set("admin", ug.Admin)
 	d.Set("role_ids", roleIDs)
+	// only report the members in the form they are configured in
+	if attributeConfigured(d, "user_logins") {
+		d.Set("user_logins", userLogins)
+	}
+	if attributeConfigured(d, "user_ids") {
+		d.Set("user_ids", userIDs)
+	}
+	if attributeConfigured(d, "usergroup_ids") {
+		d.Set("usergroup_ids", userGroupIDs)
+	}
 	d.Set("roles", roleList)
 	d.Set("created_at", ug.CreatedAt)
 	d.Set("updated_at", ug.UpdatedAt)
@@ -163,6 +198,28 @@ func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 		createBody.UserGroup.RoleIDs = &roleIDs
 	}
 
+	if _, ok := d.GetOk("user_ids"); ok {
+		userIDs := userGroupConfiguredUserIDs(d)
+		createBody.UserGroup.UserIDs = &userIDs
+	}
+
+	if ul, ok := d.GetOk("user_logins"); ok {
+		userIDs, err := userIDsFromLogins(client, ul.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createBody.UserGroup.UserIDs = &userIDs
+	}
+
+	if ugi, ok := d.GetOk("usergroup_ids"); ok {
+		rawUserGroupIDs := ugi.(*schema.Set).List()
+		userGroupIDs := []int{}
+		for x := range rawUserGroupIDs {
+			userGroupIDs = append(userGroupIDs, rawUserGroupIDs[x].(int))
+		}
+		createBody.UserGroup.UserGroupIDs = &userGroupIDs
+	}
+
 	ug, _, err := client.UserGroups.Create(context.Background(), *createBody)
 	if err != nil {
 		return diag.FromErr(err)
@@ -200,6 +257,67 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		updateBody.UserGroup.RoleIDs = &roleIDs
 	}
 
+	manageUsers := d.HasChanges("user_ids", "user_logins") && (attributeConfigured(d, "user_ids") || attributeConfigured(d, "user_logins"))
+	manageUserGroups := d.HasChange("usergroup_ids") && attributeConfigured(d, "usergroup_ids")
+
+	if manageUsers || manageUserGroups {
+		// members can only be replaced as a whole, so apply the changes made in the
+		// configuration to the current members of the group
+		ug, _, err := client.UserGroups.Get(context.Background(), ugID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if manageUsers {
+			currentUserIDs := []int{}
+			members := make(map[string]int)
+			for _, x := range *ug.Users {
+				currentUserIDs = append(currentUserIDs, *x.ID)
+				members[*x.Login] = *x.ID
+			}
+
+			oldIDs, newIDs := d.GetChange("user_ids")
+			oldLogins, newLogins := d.GetChange("user_logins")
+
+			// logins that are no longer members cannot be removed, so they are not looked up
+			oldUserIDs, err := userGroupUserIDs(client, oldIDs.(*schema.Set).List(), oldLogins.(*schema.Set).List(), members, false)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			newUserIDs, err := userGroupUserIDs(client, newIDs.(*schema.Set).List(), newLogins.(*schema.Set).List(), members, true)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			userAddList, userRemoveList := intListChanges(oldUserIDs, newUserIDs)
+			userIDs := applyIntListChanges(currentUserIDs, userAddList, userRemoveList)
+			updateBody.UserGroup.UserIDs = &userIDs
+		}
+
+		if manageUserGroups {
+			oldUG, newUG := d.GetChange("usergroup_ids")
+			rawOldUG := oldUG.(*schema.Set).List()
+			rawNewUG := newUG.(*schema.Set).List()
+			oldUserGroupIDs := []int{}
+			for x := range rawOldUG {
+				oldUserGroupIDs = append(oldUserGroupIDs, rawOldUG[x].(int))
+			}
+			newUserGroupIDs := []int{}
+			for x := range rawNewUG {
+				newUserGroupIDs = append(newUserGroupIDs, rawNewUG[x].(int))
+			}
+
+			currentUserGroupIDs := []int{}
+			for _, x := range *ug.UserGroups {
+				currentUserGroupIDs = append(currentUserGroupIDs, *x.ID)
+			}
+
+			ugAddList, ugRemoveList := intListChanges(oldUserGroupIDs, newUserGroupIDs)
+			userGroupIDs := applyIntListChanges(currentUserGroupIDs, ugAddList, ugRemoveList)
+			updateBody.UserGroup.UserGroupIDs = &userGroupIDs
+		}
+	}
+
 	_, _, err = client.UserGroups.Update(context.Background(), ugID, *updateBody)
 	if err != nil {
 		return diag.FromErr(err)
@@ -224,3 +342,130 @@ func resourceUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 	return nil
 }
+
+func userGroupConfiguredUserIDs(d *schema.ResourceData) []int {
+	rawUserIDs := d.Get("user_ids").(*schema.Set).List()
+	userIDs := []int{}
+	for x := range rawUserIDs {
+		userIDs = append(userIDs, rawUserIDs[x].(int))
+	}
+	return userIDs
+}
+
+// userGroupUserIDs returns the IDs of the users in rawIDs and rawLogins. Logins of
+// current members are resolved with members, and the other logins are looked up
+// when lookup is true and skipped otherwise.
+func userGroupUserIDs(client gosatellite.Client, rawIDs []interface{}, rawLogins []interface{}, members map[string]int, lookup bool) ([]int, error) {
+	userIDs := []int{}
+	for x := range rawIDs {
+		userIDs = append(userIDs, rawIDs[x].(int))
+	}
+
+	unknownLogins := []interface{}{}
+	for x := range rawLogins {
+		if id, ok := members[rawLogins[x].(string)]; ok {
+			userIDs = append(userIDs, id)
+		} else if lookup {
+			unknownLogins = append(unknownLogins, rawLogins[x])
+		}
+	}
+
+	lookedUpIDs, err := userIDsFromLogins(client, unknownLogins)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(userIDs, lookedUpIDs...), nil
+}
+
+// userIDsFromLogins looks up the ID of each user login in logins.
+func userIDsFromLogins(client gosatellite.Client, logins []interface{}) ([]int, error) {
+	userIDs := []int{}
+	for x := range logins {
+		login := logins[x].(string)
+
+		opt := new(gosatellite.UsersListOptions)
+		opt.Search = fmt.Sprintf("login=\"%s\"", login)
+
+		userList, err := listAllPages(&opt.ListOptions, func() (*gosatellite.ListResponse, *[]gosatellite.User, error) {
+			u, _, err := client.Users.List(context.Background(), opt)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &u.ListResponse, u.Results, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(userList) != 1 {
+			return nil, fmt.Errorf("%d users found with login %s", len(userList), login)
+		}
+
+		userIDs = append(userIDs, *userList[0].ID)
+	}
+
+	return userIDs, nil
+}
+
+// intListChanges returns the values that were added to and removed from oldList to produce newList.
+func intListChanges(oldList []int, newList []int) ([]int, []int) {
+	removeList := []int{}
+	for x := range oldList {
+		found := false
+		for y := range newList {
+			if oldList[x] == newList[y] {
+				found = true
+			}
+		}
+		if !found {
+			removeList = append(removeList, oldList[x])
+		}
+	}
+
+	addList := []int{}
+	for x := range newList {
+		found := false
+		for y := range oldList {
+			if newList[x] == oldList[y] {
+				found = true
+			}
+		}
+		if !found {
+			addList = append(addList, newList[x])
+		}
+	}
+
+	return addList, removeList
+}
+
+// applyIntListChanges removes the values in removeList from list and appends the
+// values in addList that are not already present.
+func applyIntListChanges(list []int, addList []int, removeList []int) []int {
+	result := []int{}
+	for x := range list {
+		removed := false
+		for y := range removeList {
+			if list[x] == removeList[y] {
+				removed = true
+			}
+		}
+		if !removed {
+			result = append(result, list[x])
+		}
+	}
+
+	for x := range addList {
+		found := false
+		for y := range result {
+			if addList[x] == result[y] {
+				found = true
+			}
+		}
+		if !found {
+			result = append(result, addList[x])
+		}
+	}
+
+	return result
+}
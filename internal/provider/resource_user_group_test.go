@@ -2,6 +2,7 @@ package provider
 
 import (
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,3 +31,93 @@ resource "scaffolding_resource" "foo" {
   sample_attribute = "bar"
 }
 `
+
+func TestIntListChanges(t *testing.T) {
+	cases := []struct {
+		name   string
+		old    []int
+		new    []int
+		add    []int
+		remove []int
+	}{
+		{"no change", []int{1, 2}, []int{2, 1}, []int{}, []int{}},
+		{"added", []int{1}, []int{1, 2, 3}, []int{2, 3}, []int{}},
+		{"removed", []int{1, 2, 3}, []int{2}, []int{}, []int{1, 3}},
+		{"replaced", []int{1, 2}, []int{2, 3}, []int{3}, []int{1}},
+		{"emptied", []int{1, 2}, []int{}, []int{}, []int{1, 2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			add, remove := intListChanges(c.old, c.new)
+			if !sameIntSet(add, c.add) {
+				t.Fatalf("expected %v to be added, got %v", c.add, add)
+			}
+			if !sameIntSet(remove, c.remove) {
+				t.Fatalf("expected %v to be removed, got %v", c.remove, remove)
+			}
+		})
+	}
+}
+
+func TestApplyIntListChanges(t *testing.T) {
+	cases := []struct {
+		name   string
+		list   []int
+		add    []int
+		remove []int
+		result []int
+	}{
+		{"no changes", []int{1, 2}, []int{}, []int{}, []int{1, 2}},
+		{"keeps members not in the changes", []int{1, 2, 5}, []int{3}, []int{1}, []int{2, 3, 5}},
+		{"does not duplicate members", []int{1, 2}, []int{2, 3}, []int{}, []int{1, 2, 3}},
+		{"ignores members already removed", []int{1}, []int{}, []int{1, 4}, []int{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := applyIntListChanges(c.list, c.add, c.remove)
+			if len(result) != len(c.result) || !sameIntSet(result, c.result) {
+				t.Fatalf("expected %v, got %v", c.result, result)
+			}
+		})
+	}
+}
+
+func TestUserGroupMembersConfigured(t *testing.T) {
+	state := map[string]string{
+		"name":            "admins",
+		"user_ids.#":      "2",
+		"user_ids.0":      "3",
+		"user_ids.1":      "5",
+		"usergroup_ids.#": "1",
+		"usergroup_ids.0": "7",
+	}
+
+	cases := []struct {
+		name       string
+		config     string
+		configured []string
+	}{
+		{"members configured", `{"name": "admins", "user_ids": [3, 5], "usergroup_ids": [7]}`, []string{"user_ids", "usergroup_ids"}},
+		{"no members", `{"name": "admins", "user_ids": [], "usergroup_ids": []}`, []string{"user_ids", "usergroup_ids"}},
+		{"logins configured", `{"name": "admins", "user_logins": ["jdoe"]}`, []string{"user_logins"}},
+		{"members not set", `{"name": "admins"}`, []string{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := testResourceData(t, resourceUserGroup(), state, c.config)
+
+			configured := []string{}
+			for _, key := range []string{"user_ids", "user_logins", "usergroup_ids"} {
+				if attributeConfigured(d, key) {
+					configured = append(configured, key)
+				}
+			}
+			if strings.Join(configured, ",") != strings.Join(c.configured, ",") {
+				t.Fatalf("expected %v to be managed, got %v", c.configured, configured)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUser(t *testing.T) {
+	t.Skip("resource not yet implemented, remove this once you add your own code")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"scaffolding_resource.foo", "sample_attribute", regexp.MustCompile("^ba")),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceUser = `
+resource "scaffolding_resource" "foo" {
+  sample_attribute = "bar"
+}
+`
+
+func TestUserClearedDefaults(t *testing.T) {
+	state := map[string]string{
+		"login":                   "jdoe",
+		"auth_source_id":          "1",
+		"default_location_id":     "2",
+		"default_organization_id": "3",
+	}
+
+	cases := []struct {
+		name    string
+		config  string
+		cleared []string
+	}{
+		{"both kept", `{"login": "jdoe", "auth_source_id": 1, "default_location_id": 2, "default_organization_id": 3}`, []string{}},
+		{"location changed", `{"login": "jdoe", "auth_source_id": 1, "default_location_id": 4, "default_organization_id": 3}`, []string{}},
+		{"location cleared", `{"login": "jdoe", "auth_source_id": 1, "default_organization_id": 3}`, []string{"default_location_id"}},
+		{"both cleared", `{"login": "jdoe", "auth_source_id": 1}`, []string{"default_location_id", "default_organization_id"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := testResourceData(t, resourceUser(), state, c.config)
+
+			cleared := clearedIDAttributes(d, "default_location_id", "default_organization_id")
+			if strings.Join(cleared, ",") != strings.Join(c.cleared, ",") {
+				t.Fatalf("expected %v to be cleared, got %v", c.cleared, cleared)
+			}
+		})
+	}
+}